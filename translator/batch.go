@@ -0,0 +1,83 @@
+package translator
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// defaultBatchConcurrency is used when BatchOptions.MaxConcurrency is zero or
+// negative.
+const defaultBatchConcurrency = 4
+
+// EstimateTokens applies the same rough estimate (~4 chars/token, 100
+// minimum) every provider already uses when sizing rate-limiter waits.
+func EstimateTokens(text string) int {
+	estimated := len(text) / 4
+	if estimated < 100 {
+		estimated = 100
+	}
+	return estimated
+}
+
+// RunBatch dispatches translateOne over reqs, running up to
+// opts.MaxConcurrency calls at once, and returns one BatchResult per request
+// in the same order as reqs. Requests are issued smallest-estimated-tokens
+// first so more of them fit inside a shared rate limiter's per-minute budget
+// before it has to block on a single large one. translateOne is expected to
+// already apply the provider's own rate limiting and retries (as each
+// provider's Translate does), so RunBatch only bounds concurrency and
+// ordering, not correctness.
+func RunBatch(
+	ctx context.Context,
+	reqs []*TranslationRequest,
+	opts *BatchOptions,
+	translateOne func(ctx context.Context, req *TranslationRequest) (*TranslationResponse, error),
+) []BatchResult {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	maxConcurrency := opts.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultBatchConcurrency
+	}
+
+	order := make([]int, len(reqs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return EstimateTokens(reqs[order[a]].Text) < EstimateTokens(reqs[order[b]].Text)
+	})
+
+	results := make([]BatchResult, len(reqs))
+
+	var mu sync.Mutex
+	done := 0
+
+	sem := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+
+	for _, idx := range order {
+		idx := idx
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := translateOne(ctx, reqs[idx])
+			results[idx] = BatchResult{Response: resp, Err: err}
+
+			if opts.OnProgress != nil {
+				mu.Lock()
+				done++
+				opts.OnProgress(done, len(reqs))
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	return results
+}
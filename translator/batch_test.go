@@ -0,0 +1,115 @@
+package translator_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ownlingo/ownlingo/translator"
+)
+
+func TestRunBatchPreservesOrder(t *testing.T) {
+	reqs := []*translator.TranslationRequest{
+		// Over 400 characters, so its estimate exceeds EstimateTokens' 100-token
+		// floor and it is dispatched after the two short requests.
+		{Text: strings.Repeat("a long request to push this one later in the dispatch order. ", 8)},
+		{Text: "short"},
+		{Text: "medium length request"},
+	}
+
+	results := translator.RunBatch(context.Background(), reqs, nil, func(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+		return &translator.TranslationResponse{TranslatedText: "t:" + req.Text}, nil
+	})
+
+	if len(results) != len(reqs) {
+		t.Fatalf("expected %d results, got %d", len(reqs), len(results))
+	}
+	for i, req := range reqs {
+		if results[i].Err != nil {
+			t.Fatalf("result %d: unexpected error: %v", i, results[i].Err)
+		}
+		if results[i].Response.TranslatedText != "t:"+req.Text {
+			t.Errorf("result %d out of order: got %q", i, results[i].Response.TranslatedText)
+		}
+	}
+}
+
+func TestRunBatchPartialFailure(t *testing.T) {
+	reqs := []*translator.TranslationRequest{
+		{Text: "ok"},
+		{Text: "fails"},
+		{Text: "ok again"},
+	}
+
+	results := translator.RunBatch(context.Background(), reqs, nil, func(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+		if req.Text == "fails" {
+			return nil, errors.New("boom")
+		}
+		return &translator.TranslationResponse{TranslatedText: "t:" + req.Text}, nil
+	})
+
+	if results[1].Err == nil {
+		t.Fatal("expected the failing request to report an error")
+	}
+	if results[0].Err != nil || results[2].Err != nil {
+		t.Fatal("expected the other requests to succeed despite one failure")
+	}
+}
+
+func TestRunBatchRespectsMaxConcurrency(t *testing.T) {
+	reqs := make([]*translator.TranslationRequest, 10)
+	for i := range reqs {
+		reqs[i] = &translator.TranslationRequest{Text: "hello"}
+	}
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+
+	opts := &translator.BatchOptions{MaxConcurrency: 2}
+	translator.RunBatch(context.Background(), reqs, opts, func(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		return &translator.TranslationResponse{}, nil
+	})
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 concurrent calls, observed %d", maxInFlight)
+	}
+}
+
+func TestRunBatchReportsProgress(t *testing.T) {
+	reqs := []*translator.TranslationRequest{{Text: "a"}, {Text: "b"}, {Text: "c"}}
+
+	var mu sync.Mutex
+	var lastDone, lastTotal int
+
+	opts := &translator.BatchOptions{
+		OnProgress: func(done, total int) {
+			mu.Lock()
+			defer mu.Unlock()
+			lastDone, lastTotal = done, total
+		},
+	}
+
+	translator.RunBatch(context.Background(), reqs, opts, func(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+		return &translator.TranslationResponse{}, nil
+	})
+
+	if lastDone != 3 || lastTotal != 3 {
+		t.Errorf("expected final progress callback to report 3/3, got %d/%d", lastDone, lastTotal)
+	}
+}
@@ -0,0 +1,290 @@
+// Package chunk wraps an AITranslator so that requests whose text would
+// exceed a provider's practical input budget are automatically split into
+// smaller pieces, translated independently, and reassembled into a single
+// response. Splitting respects paragraph, sentence, and HTML/Liquid tag
+// boundaries, so Wrap composes cleanly in front of fallback.NewChain or any
+// retry/rate-limit-wrapped provider: each piece goes through the inner
+// AITranslator's own retry and rate limiting exactly as a normal request
+// would.
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ownlingo/ownlingo/translator"
+)
+
+// estimateTokens delegates to translator.EstimateTokens so the decision of
+// whether a whole request needs splitting uses the same estimate providers
+// use to size their own rate-limiter waits.
+func estimateTokens(text string) int {
+	return translator.EstimateTokens(text)
+}
+
+// Options configures how Wrap splits oversized requests.
+type Options struct {
+	// MaxTokens is the largest estimated input size, per piece, to send to
+	// the inner translator. Required; Wrap panics if it's not positive.
+	MaxTokens int
+
+	// OverlapTokens carries this many estimated tokens of trailing context
+	// from one piece into the start of the next, to help the translator
+	// keep pronouns and references consistent across a cut. Zero disables
+	// overlap.
+	OverlapTokens int
+}
+
+// Translator wraps an inner AITranslator, splitting any request whose text
+// exceeds Options.MaxTokens into smaller pieces before delegating.
+type Translator struct {
+	inner translator.AITranslator
+	opts  Options
+}
+
+// Wrap returns a Translator that splits oversized requests before passing
+// them to inner. Panics if inner is nil or opts.MaxTokens is not positive.
+func Wrap(inner translator.AITranslator, opts Options) *Translator {
+	if inner == nil {
+		panic("inner translator cannot be nil")
+	}
+	if opts.MaxTokens <= 0 {
+		panic("MaxTokens must be > 0")
+	}
+
+	return &Translator{inner: inner, opts: opts}
+}
+
+// Name returns the inner translator's name; chunking is transparent to
+// callers that just want to know which provider ultimately served a
+// request.
+func (t *Translator) Name() string {
+	return t.inner.Name()
+}
+
+// FailedChunk identifies one piece of a split request that failed to
+// translate, by its position (Index, out of Total pieces split from the
+// request) and original source text, so a caller can retry it independently
+// of the pieces that already succeeded.
+type FailedChunk struct {
+	Index int
+	Total int
+	Text  string
+	Err   error
+}
+
+// PartialTranslationError is returned by Translate when at least one piece
+// of a split request failed. Partial reassembles every piece that did
+// succeed, in order, with TokensUsed/Cost summed across just those pieces;
+// Failed lists the source text and error of every piece that didn't, so a
+// caller can retry those directly against the inner translator without
+// resplitting or redoing the pieces that already succeeded.
+type PartialTranslationError struct {
+	Partial *translator.TranslationResponse
+	Failed  []FailedChunk
+}
+
+func (e *PartialTranslationError) Error() string {
+	msgs := make([]string, len(e.Failed))
+	for i, f := range e.Failed {
+		msgs[i] = fmt.Sprintf("chunk %d/%d: %v", f.Index+1, f.Total, f.Err)
+	}
+	total := 0
+	if len(e.Failed) > 0 {
+		total = e.Failed[0].Total
+	}
+	return fmt.Sprintf("chunk: %d/%d pieces failed: %s", len(e.Failed), total, strings.Join(msgs, "; "))
+}
+
+// Unwrap exposes every failed piece's error to errors.Is/errors.As, per Go's
+// multi-error Unwrap() []error convention.
+func (e *PartialTranslationError) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, f := range e.Failed {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
+// Translate splits req.Text into pieces if needed, translates each against
+// the inner translator, and reassembles the pieces back into one response
+// with summed token usage and cost. If one or more pieces fail, Translate
+// returns a *PartialTranslationError instead of a response: it carries both
+// the pieces that did succeed (so that work isn't thrown away) and the
+// source text of each piece that failed (so a caller can retry just those,
+// via a direct call to the inner translator, without resplitting or redoing
+// the rest of the request).
+func (t *Translator) Translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+	pieces := split(req.Text, t.opts)
+	if len(pieces) <= 1 {
+		return t.inner.Translate(ctx, req)
+	}
+
+	start := time.Now()
+
+	subReqs := make([]*translator.TranslationRequest, len(pieces))
+	for i, piece := range pieces {
+		sub := *req
+		sub.Text = piece
+		subReqs[i] = &sub
+	}
+
+	results, err := t.inner.TranslateBatch(ctx, subReqs, nil)
+	if err != nil {
+		return nil, fmt.Errorf("chunk: translating pieces: %w", err)
+	}
+
+	var sb strings.Builder
+	var usage translator.TokenUsage
+	var cost translator.Cost
+	var failed []FailedChunk
+
+	for i, res := range results {
+		if res.Err != nil {
+			failed = append(failed, FailedChunk{Index: i, Total: len(results), Text: pieces[i], Err: res.Err})
+			continue
+		}
+
+		if i == 0 || t.opts.OverlapTokens <= 0 {
+			sb.WriteString(res.Response.TranslatedText)
+		} else {
+			sb.WriteString(stripOverlap(sb.String(), res.Response.TranslatedText))
+		}
+
+		usage.InputTokens += res.Response.TokensUsed.InputTokens
+		usage.OutputTokens += res.Response.TokensUsed.OutputTokens
+		usage.TotalTokens += res.Response.TokensUsed.TotalTokens
+		cost.Amount += res.Response.Cost.Amount
+		if cost.Currency == "" {
+			cost.Currency = res.Response.Cost.Currency
+		}
+	}
+
+	partial := &translator.TranslationResponse{
+		TranslatedText: sb.String(),
+		SourceText:     req.Text,
+		TokensUsed:     usage,
+		Cost:           cost,
+		Provider:       t.inner.Name(),
+		Duration:       time.Since(start),
+	}
+
+	if len(failed) > 0 {
+		return nil, &PartialTranslationError{Partial: partial, Failed: failed}
+	}
+
+	return partial, nil
+}
+
+// TranslateStream splits req.Text if needed and streams each piece's
+// translation in order against the inner translator. Each piece's output is
+// collected until that piece's stream completes, then sent as a single text
+// delta (with any overlap stripped), so output arrives piece by piece rather
+// than token by token. When the text fits in one piece, it delegates
+// directly so the caller gets the inner translator's own streaming behavior
+// unchanged.
+func (t *Translator) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	pieces := split(req.Text, t.opts)
+	if len(pieces) <= 1 {
+		return t.inner.TranslateStream(ctx, req)
+	}
+
+	out := make(chan translator.StreamChunk)
+
+	go func() {
+		defer close(out)
+
+		start := time.Now()
+		var sb strings.Builder
+		var usage translator.TokenUsage
+		var cost translator.Cost
+
+		for i, piece := range pieces {
+			sub := *req
+			sub.Text = piece
+
+			stream, err := t.inner.TranslateStream(ctx, &sub)
+			if err != nil {
+				out <- translator.StreamChunk{Err: fmt.Errorf("chunk %d/%d: %w", i+1, len(pieces), err)}
+				return
+			}
+
+			pieceTranslated := ""
+			for sc := range stream {
+				if sc.Err != nil {
+					out <- translator.StreamChunk{Err: fmt.Errorf("chunk %d/%d: %w", i+1, len(pieces), sc.Err)}
+					return
+				}
+				if sc.Done {
+					usage.InputTokens += sc.Response.TokensUsed.InputTokens
+					usage.OutputTokens += sc.Response.TokensUsed.OutputTokens
+					usage.TotalTokens += sc.Response.TokensUsed.TotalTokens
+					cost.Amount += sc.Response.Cost.Amount
+					if cost.Currency == "" {
+						cost.Currency = sc.Response.Cost.Currency
+					}
+					pieceTranslated = sc.Response.TranslatedText
+					continue
+				}
+				pieceTranslated += sc.TextDelta
+			}
+
+			var toEmit string
+			if i == 0 || t.opts.OverlapTokens <= 0 {
+				toEmit = pieceTranslated
+			} else {
+				toEmit = stripOverlap(sb.String(), pieceTranslated)
+			}
+			sb.WriteString(toEmit)
+			out <- translator.StreamChunk{TextDelta: toEmit}
+		}
+
+		out <- translator.StreamChunk{
+			Done: true,
+			Response: &translator.TranslationResponse{
+				TranslatedText: sb.String(),
+				SourceText:     req.Text,
+				TokensUsed:     usage,
+				Cost:           cost,
+				Provider:       t.inner.Name(),
+				Duration:       time.Since(start),
+			},
+		}
+	}()
+
+	return out, nil
+}
+
+// TranslateBatch translates multiple requests, dispatching up to
+// opts.MaxConcurrency at a time; each one goes through Translate, so it's
+// split and reassembled the same as a standalone call.
+func (t *Translator) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	return translator.RunBatch(ctx, reqs, opts, t.Translate), nil
+}
+
+// stripOverlap removes the translated rendering of a piece's carried-over
+// overlap from the start of next, so reassembly doesn't duplicate it. It
+// looks for the longest suffix of prevTranslated (already-accumulated
+// output) that is also a prefix of next, which is an approximation: a
+// translator isn't guaranteed to render identical source text identically
+// across two independent calls. When no match is found, next is returned
+// unchanged and the overlap is left visible in the output rather than
+// risking cutting real content.
+func stripOverlap(prevTranslated, next string) string {
+	prevRunes := []rune(prevTranslated)
+	nextRunes := []rune(next)
+
+	maxLen := len(prevRunes)
+	if len(nextRunes) < maxLen {
+		maxLen = len(nextRunes)
+	}
+
+	for l := maxLen; l > 0; l-- {
+		if string(prevRunes[len(prevRunes)-l:]) == string(nextRunes[:l]) {
+			return string(nextRunes[l:])
+		}
+	}
+	return next
+}
@@ -0,0 +1,210 @@
+package chunk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/chunk"
+)
+
+// countingTranslator records how many times each distinct request text was
+// translated, and fails any text that appears in failTexts.
+type countingTranslator struct {
+	mu        sync.Mutex
+	calls     map[string]int
+	failTexts map[string]bool
+}
+
+func newCountingTranslator(failTexts ...string) *countingTranslator {
+	fail := make(map[string]bool, len(failTexts))
+	for _, t := range failTexts {
+		fail[t] = true
+	}
+	return &countingTranslator{calls: make(map[string]int), failTexts: fail}
+}
+
+func (c *countingTranslator) Name() string { return "counting" }
+
+func (c *countingTranslator) Translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+	c.mu.Lock()
+	c.calls[req.Text]++
+	c.mu.Unlock()
+
+	if c.failTexts[req.Text] {
+		return nil, errors.New("translation failed")
+	}
+
+	return &translator.TranslationResponse{
+		TranslatedText: strings.ToUpper(req.Text),
+		SourceText:     req.Text,
+		Provider:       c.Name(),
+		TokensUsed:     translator.TokenUsage{InputTokens: 1, OutputTokens: 1, TotalTokens: 2},
+		Cost:           translator.Cost{Amount: 0.01, Currency: "USD"},
+	}, nil
+}
+
+func (c *countingTranslator) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	return translator.RunBatch(ctx, reqs, opts, c.Translate), nil
+}
+
+func (c *countingTranslator) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	resp, err := c.Translate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan translator.StreamChunk, 1)
+	out <- translator.StreamChunk{Done: true, Response: resp}
+	close(out)
+	return out, nil
+}
+
+func (c *countingTranslator) callCount(text string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls[text]
+}
+
+func (c *countingTranslator) totalCalls() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	total := 0
+	for _, n := range c.calls {
+		total += n
+	}
+	return total
+}
+
+func TestWrapPassesThroughUnderLimit(t *testing.T) {
+	inner := newCountingTranslator()
+	tr := chunk.Wrap(inner, chunk.Options{MaxTokens: 1000})
+
+	req := &translator.TranslationRequest{Text: "a short sentence that fits easily.", SourceLanguage: "en", TargetLanguage: "es"}
+
+	resp, err := tr.Translate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if resp.TranslatedText != strings.ToUpper(req.Text) {
+		t.Errorf("unexpected translation: %q", resp.TranslatedText)
+	}
+	if inner.totalCalls() != 1 {
+		t.Errorf("expected exactly 1 inner call for a pass-through request, got %d", inner.totalCalls())
+	}
+}
+
+func TestWrapSplitsAcrossHTMLTags(t *testing.T) {
+	inner := newCountingTranslator()
+	// A small MaxTokens forces a split; the text is built from several
+	// paragraphs each wrapping a chunk of prose in a <p> tag.
+	tr := chunk.Wrap(inner, chunk.Options{MaxTokens: 20})
+
+	text := "<p>" + strings.Repeat("word ", 30) + "</p>\n\n<p>" + strings.Repeat("other ", 30) + "</p>"
+	req := &translator.TranslationRequest{Text: text, SourceLanguage: "en", TargetLanguage: "es", PreserveHTML: true}
+
+	resp, err := tr.Translate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if inner.totalCalls() < 2 {
+		t.Fatalf("expected the oversized request to be split into multiple pieces, got %d calls", inner.totalCalls())
+	}
+
+	for text := range inner.calls {
+		if strings.Count(text, "<") != strings.Count(text, ">") {
+			t.Errorf("piece has an unbalanced angle bracket, a tag boundary was cut: %q", text)
+		}
+	}
+
+	if !strings.Contains(resp.TranslatedText, "<P>") {
+		t.Errorf("expected reassembled text to still contain the translated tag, got %q", resp.TranslatedText)
+	}
+}
+
+func TestWrapSplitsLiquidBlocksSpanningSentences(t *testing.T) {
+	inner := newCountingTranslator()
+	tr := chunk.Wrap(inner, chunk.Options{MaxTokens: 15})
+
+	text := "First sentence here. {% if user.name %}Hello {{ user.name }}, welcome back!{% endif %} Second sentence follows after that."
+	req := &translator.TranslationRequest{Text: text, SourceLanguage: "en", TargetLanguage: "es", PreserveLiquid: true}
+
+	_, err := tr.Translate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for text := range inner.calls {
+		if strings.Count(text, "{%") != strings.Count(text, "%}") {
+			t.Errorf("Liquid tag was cut across pieces: %q", text)
+		}
+		if strings.Count(text, "{{") != strings.Count(text, "}}") {
+			t.Errorf("Liquid variable was cut across pieces: %q", text)
+		}
+	}
+}
+
+func TestWrapPartialFailureDoesNotRedoSucceededChunks(t *testing.T) {
+	// Each sentence is numbered so every piece chunk produces has unique
+	// content; a repetitive fixture would let two distinct pieces collide on
+	// the same text and make the per-piece call counts below meaningless.
+	var sb strings.Builder
+	for i := 0; i < 10; i++ {
+		fmt.Fprintf(&sb, "Sentence number %d has some unique padding words in it. ", i)
+	}
+	sb.WriteString("THIS-CHUNK-FAILS ")
+	for i := 10; i < 20; i++ {
+		fmt.Fprintf(&sb, "Sentence number %d has some unique padding words in it. ", i)
+	}
+	text := sb.String()
+
+	// Discover the pieces chunk would produce by calling once and
+	// recording which piece contains the failure marker, then re-run with
+	// that exact piece set as the failing text.
+	probeInner := newCountingTranslator()
+	probe := chunk.Wrap(probeInner, chunk.Options{MaxTokens: 15})
+	_, _ = probe.Translate(context.Background(), &translator.TranslationRequest{Text: text})
+
+	pieces := map[string]bool{}
+	var failingText string
+	for txt := range probeInner.calls {
+		if strings.Contains(txt, "THIS-CHUNK-FAILS") {
+			failingText = txt
+		}
+		pieces[txt] = true
+	}
+	if failingText == "" {
+		t.Fatalf("expected one piece to contain the failure marker, calls: %v", probeInner.calls)
+	}
+
+	failing := newCountingTranslator(failingText)
+	tr := chunk.Wrap(failing, chunk.Options{MaxTokens: 15})
+
+	_, err := tr.Translate(context.Background(), &translator.TranslationRequest{Text: text})
+	if err == nil {
+		t.Fatal("expected an error when one chunk fails")
+	}
+
+	for txt := range pieces {
+		if got := failing.callCount(txt); got != 1 {
+			t.Errorf("expected piece %q to be translated exactly once, got %d calls", txt, got)
+		}
+	}
+
+	var partialErr *chunk.PartialTranslationError
+	if !errors.As(err, &partialErr) {
+		t.Fatalf("expected a *chunk.PartialTranslationError, got %T: %v", err, err)
+	}
+	if len(partialErr.Failed) != 1 || partialErr.Failed[0].Text != failingText {
+		t.Fatalf("expected exactly the failing piece reported, got %+v", partialErr.Failed)
+	}
+	if partialErr.Partial == nil || !strings.Contains(partialErr.Partial.TranslatedText, "SENTENCE NUMBER 0") {
+		t.Errorf("expected the partial response to still contain succeeded pieces, got %+v", partialErr.Partial)
+	}
+	if strings.Contains(partialErr.Partial.TranslatedText, "THIS-CHUNK-FAILS") {
+		t.Errorf("expected the failed piece's text not to appear in the partial response, got %q", partialErr.Partial.TranslatedText)
+	}
+}
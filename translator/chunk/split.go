@@ -0,0 +1,208 @@
+package chunk
+
+import (
+	"regexp"
+	"strings"
+)
+
+// protectedPattern matches HTML tags and Liquid blocks, which must never be
+// cut in the middle of a split. It is intentionally permissive (it doesn't
+// validate tag/Liquid syntax) since the splitter only needs to avoid
+// cutting inside whatever matches, not parse it.
+var protectedPattern = regexp.MustCompile(`(?s)<[^>]+>|\{%.*?%\}|\{\{.*?\}\}`)
+
+// sentenceEndPattern matches a token (word plus its trailing whitespace)
+// that ends a sentence: a '.', '!' or '?', optionally followed by closing
+// quotes/brackets, then whitespace.
+var sentenceEndPattern = regexp.MustCompile(`[.!?]["')\]]*\s*$`)
+
+// tokenPattern splits plain text into words-with-trailing-whitespace, the
+// finest granularity the splitter ever cuts at.
+var tokenPattern = regexp.MustCompile(`\S+\s*`)
+
+// atom is one indivisible piece of text: either a protected HTML/Liquid
+// match, or a single word (with its trailing whitespace). The splitter only
+// ever cuts between atoms, never inside one, so a chunk boundary can never
+// land inside a tag.
+type atom struct {
+	text         string
+	protected    bool
+	paragraphEnd bool // text ends a paragraph (two or more newlines follow)
+	sentenceEnd  bool // text ends a sentence
+}
+
+// estimateAtomTokens is the same ~4-chars-per-token rule
+// translator.EstimateTokens uses, without its 100-token floor: that floor
+// makes sense for sizing a whole request but would make every atom look
+// enormous here.
+func estimateAtomTokens(s string) int {
+	return len(s) / 4
+}
+
+// atomize breaks text into the atom sequence described above, preserving
+// every byte of the original text across the returned atoms (joining their
+// text back together reproduces text exactly).
+func atomize(text string) []atom {
+	var atoms []atom
+
+	lastEnd := 0
+	for _, m := range protectedPattern.FindAllStringIndex(text, -1) {
+		start, end := m[0], m[1]
+		if start > lastEnd {
+			atoms = append(atoms, atomizePlain(text[lastEnd:start])...)
+		}
+		atoms = append(atoms, atom{text: text[start:end], protected: true})
+		lastEnd = end
+	}
+	if lastEnd < len(text) {
+		atoms = append(atoms, atomizePlain(text[lastEnd:])...)
+	}
+
+	return atoms
+}
+
+// atomizePlain splits a run of text with no protected matches into
+// word-level atoms, flagging the ones that end a sentence or paragraph so
+// the packer can prefer cutting there.
+func atomizePlain(s string) []atom {
+	if s == "" {
+		return nil
+	}
+
+	tokens := tokenPattern.FindAllString(s, -1)
+	if len(tokens) == 0 {
+		// s is pure whitespace (e.g. the gap between two tags); keep it as
+		// its own atom rather than dropping it.
+		return []atom{{text: s}}
+	}
+
+	// \S+\s* requires a non-whitespace start, so leading whitespace before
+	// the first token is otherwise lost. Fold it back into the first token.
+	if joined := strings.Join(tokens, ""); len(joined) < len(s) {
+		tokens[0] = s[:len(s)-len(joined)] + tokens[0]
+	}
+
+	atoms := make([]atom, len(tokens))
+	for i, tok := range tokens {
+		atoms[i] = atom{
+			text:         tok,
+			paragraphEnd: strings.Contains(tok, "\n\n"),
+			sentenceEnd:  sentenceEndPattern.MatchString(tok),
+		}
+	}
+	return atoms
+}
+
+// pack groups atoms into chunks of at most maxTokens estimated tokens each,
+// returning atom-index ranges ([start, end) pairs) rather than strings so
+// callers can still slice overlap out of the original atom list afterward.
+// It prefers to cut at the most recent paragraph boundary within the
+// current chunk, falling back to the most recent sentence boundary, and
+// finally to a hard cut at the token budget (always safe, since atoms are
+// never smaller than a single word). A single atom that alone exceeds
+// maxTokens (an enormous tag, or a very long word) is never split; it goes
+// into an over-budget chunk, together with any atoms carried over after the
+// last break, instead of being cut mid-atom.
+func pack(atoms []atom, maxTokens int) [][2]int {
+	var ranges [][2]int
+
+	start := 0
+	tokens := 0
+	lastParagraphBreak := -1
+	lastSentenceBreak := -1
+
+	for i, a := range atoms {
+		t := estimateAtomTokens(a.text)
+
+		if tokens+t > maxTokens && i > start {
+			cut := i
+			switch {
+			case lastParagraphBreak >= start:
+				cut = lastParagraphBreak + 1
+			case lastSentenceBreak >= start:
+				cut = lastSentenceBreak + 1
+			}
+
+			ranges = append(ranges, [2]int{start, cut})
+			start = cut
+
+			tokens = 0
+			lastParagraphBreak, lastSentenceBreak = -1, -1
+			for j := start; j < i; j++ {
+				tokens += estimateAtomTokens(atoms[j].text)
+				if atoms[j].paragraphEnd {
+					lastParagraphBreak = j
+				}
+				if atoms[j].sentenceEnd {
+					lastSentenceBreak = j
+				}
+			}
+		}
+
+		tokens += t
+		if a.paragraphEnd {
+			lastParagraphBreak = i
+		}
+		if a.sentenceEnd {
+			lastSentenceBreak = i
+		}
+	}
+
+	ranges = append(ranges, [2]int{start, len(atoms)})
+	return ranges
+}
+
+// overlapStart walks backward from boundary, returning the earliest atom
+// index whose inclusion keeps the carried-back context within
+// overlapTokens. Working in atoms rather than raw characters means the
+// overlap can never start in the middle of a tag.
+func overlapStart(atoms []atom, boundary, overlapTokens int) int {
+	tokens := 0
+	i := boundary
+	for i > 0 {
+		t := estimateAtomTokens(atoms[i-1].text)
+		if tokens+t > overlapTokens {
+			break
+		}
+		tokens += t
+		i--
+	}
+	return i
+}
+
+func joinAtoms(atoms []atom) string {
+	var sb strings.Builder
+	for _, a := range atoms {
+		sb.WriteString(a.text)
+	}
+	return sb.String()
+}
+
+// split breaks text into pieces of at most opts.MaxTokens estimated tokens,
+// preferring to cut at paragraph boundaries, then sentence boundaries, then
+// plain whitespace, and never inside an HTML tag or Liquid block. When
+// opts.OverlapTokens is positive, each piece after the first is prefixed
+// with up to that many tokens of trailing context carried over from the
+// previous piece, to help the translator keep references consistent across
+// the cut. Returns a single-element slice, unchanged, if text already fits.
+func split(text string, opts Options) []string {
+	if opts.MaxTokens <= 0 || estimateTokens(text) <= opts.MaxTokens {
+		return []string{text}
+	}
+
+	atoms := atomize(text)
+	ranges := pack(atoms, opts.MaxTokens)
+	if len(ranges) <= 1 {
+		return []string{text}
+	}
+
+	pieces := make([]string, len(ranges))
+	for i, r := range ranges {
+		start := r[0]
+		if i > 0 && opts.OverlapTokens > 0 {
+			start = overlapStart(atoms, r[0], opts.OverlapTokens)
+		}
+		pieces[i] = joinAtoms(atoms[start:r[1]])
+	}
+	return pieces
+}
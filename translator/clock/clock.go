@@ -0,0 +1,21 @@
+// Package clock abstracts time so rate limiting and retry backoff can be
+// driven deterministically in tests (and, for advanced callers, replayed
+// against a simulated clock) instead of depending on wall-clock sleeps.
+package clock
+
+import "time"
+
+// Clock is the minimal time surface ratelimit.Limiter and retry.Do need:
+// reading the current time and waiting for a duration to elapse.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// Real is the default Clock, backed by the standard library's time package.
+var Real Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                        { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
@@ -0,0 +1,84 @@
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ownlingo/ownlingo/translator/clock"
+)
+
+func TestRealClockAfterFires(t *testing.T) {
+	start := time.Now()
+	<-clock.Real.After(10 * time.Millisecond)
+	if time.Since(start) < 10*time.Millisecond {
+		t.Error("expected Real.After to wait at least the requested duration")
+	}
+}
+
+func TestFakeNowReflectsAdvance(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+
+	fake.Advance(5 * time.Minute)
+
+	if got, want := fake.Now(), start.Add(5*time.Minute); !got.Equal(want) {
+		t.Errorf("expected Now() = %v, got %v", want, got)
+	}
+}
+
+func TestFakeAfterFiresOnAdvance(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+
+	ch := fake.After(time.Minute)
+
+	select {
+	case <-ch:
+		t.Fatal("expected After channel not to fire before the clock advances")
+	default:
+	}
+
+	fake.Advance(time.Minute)
+
+	select {
+	case <-ch:
+	default:
+		t.Fatal("expected After channel to fire once the clock reached its deadline")
+	}
+}
+
+func TestFakeAdvanceFiresEveryDueWaiter(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+
+	var fired []string
+	long := fake.After(10 * time.Minute)
+	short := fake.After(time.Minute)
+
+	fake.Advance(10 * time.Minute)
+
+	select {
+	case <-short:
+		fired = append(fired, "short")
+	default:
+		t.Fatal("expected short waiter to have fired")
+	}
+	select {
+	case <-long:
+		fired = append(fired, "long")
+	default:
+		t.Fatal("expected long waiter to have fired")
+	}
+
+	if len(fired) != 2 || fired[0] != "short" || fired[1] != "long" {
+		t.Errorf("expected short then long, got %v", fired)
+	}
+}
+
+func TestFakeAfterNonPositiveFiresImmediately(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+
+	select {
+	case <-fake.After(0):
+	default:
+		t.Fatal("expected a zero duration to fire immediately")
+	}
+}
@@ -0,0 +1,80 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Fake is a controllable Clock for tests: advancing it synchronously fires
+// the After channel of any waiter whose deadline has passed, instead of
+// requiring a real sleep.
+type Fake struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+type fakeWaiter struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+// NewFake creates a Fake clock starting at now.
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the fake clock's current time.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// After returns a channel that fires once the fake clock's time reaches
+// f.Now()+d, as driven by Advance or Set rather than wall time. A
+// non-positive d fires immediately.
+func (f *Fake) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	deadline := f.now.Add(d)
+	if !deadline.After(f.now) {
+		ch <- f.now
+		return ch
+	}
+
+	f.waiters = append(f.waiters, &fakeWaiter{deadline: deadline, ch: ch})
+	return ch
+}
+
+// Advance moves the fake clock forward by d and fires any waiters whose
+// deadline is now at or before the new time.
+func (f *Fake) Advance(d time.Duration) {
+	f.Set(f.Now().Add(d))
+}
+
+// Set moves the fake clock to t, firing the After channel of every waiter
+// whose deadline is at or before t, in deadline order.
+func (f *Fake) Set(t time.Time) {
+	f.mu.Lock()
+	f.now = t
+
+	var remaining, ready []*fakeWaiter
+	for _, w := range f.waiters {
+		if !w.deadline.After(t) {
+			ready = append(ready, w)
+		} else {
+			remaining = append(remaining, w)
+		}
+	}
+	f.waiters = remaining
+	f.mu.Unlock()
+
+	sort.Slice(ready, func(i, j int) bool { return ready[i].deadline.Before(ready[j].deadline) })
+	for _, w := range ready {
+		w.ch <- t
+	}
+}
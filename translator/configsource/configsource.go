@@ -0,0 +1,84 @@
+// Package configsource provides translator.ConfigSource implementations: a
+// JSON file reloaded on SIGHUP, and a functional variant for tests or
+// configuration that doesn't come from a file.
+package configsource
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ownlingo/ownlingo/translator"
+)
+
+// FileSource reads a translator.ReloadableConfig from a JSON file on disk,
+// re-reading it whenever the process receives SIGHUP.
+type FileSource struct {
+	path    string
+	changes chan struct{}
+}
+
+// NewFileSource creates a FileSource reading path and registers a SIGHUP
+// handler that signals Changes(). The handler runs for the life of the
+// process; there is no way to unregister it.
+func NewFileSource(path string) *FileSource {
+	f := &FileSource{
+		path:    path,
+		changes: make(chan struct{}, 1),
+	}
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGHUP)
+	go func() {
+		for range sig {
+			select {
+			case f.changes <- struct{}{}:
+			default:
+				// A reload is already pending; no need to queue another.
+			}
+		}
+	}()
+
+	return f
+}
+
+// Load reads and parses the JSON file at path.
+func (f *FileSource) Load() (*translator.ReloadableConfig, error) {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return nil, fmt.Errorf("configsource: reading %s: %w", f.path, err)
+	}
+
+	var cfg translator.ReloadableConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("configsource: parsing %s: %w", f.path, err)
+	}
+
+	return &cfg, nil
+}
+
+// Changes returns the channel signaled on SIGHUP.
+func (f *FileSource) Changes() <-chan struct{} {
+	return f.changes
+}
+
+// FuncSource adapts a plain load function and change channel into a
+// translator.ConfigSource. Useful for tests, and for configuration that
+// comes from somewhere other than a file, such as a remote config service
+// polled elsewhere in the application.
+type FuncSource struct {
+	LoadFunc   func() (*translator.ReloadableConfig, error)
+	ChangeChan <-chan struct{}
+}
+
+// Load calls LoadFunc.
+func (f *FuncSource) Load() (*translator.ReloadableConfig, error) {
+	return f.LoadFunc()
+}
+
+// Changes returns ChangeChan.
+func (f *FuncSource) Changes() <-chan struct{} {
+	return f.ChangeChan
+}
@@ -0,0 +1,215 @@
+// Package errkind classifies provider SDK errors into a small, canonical
+// taxonomy so callers (retry, router, fallback) can make policy decisions
+// without string-matching status codes out of error messages.
+package errkind
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/sashabaranov/go-openai"
+	"google.golang.org/api/googleapi"
+)
+
+// Kind is a canonical classification of a provider error, independent of
+// which SDK produced it.
+type Kind int
+
+const (
+	// Unknown means the error didn't match any typed SDK error or known
+	// transient condition; treat conservatively as non-retryable.
+	Unknown Kind = iota
+	// RateLimited means the provider returned HTTP 429.
+	RateLimited
+	// Unauthorized means the provider returned HTTP 401 or 403; retrying
+	// with the same credentials will not help.
+	Unauthorized
+	// BadRequest means the provider returned HTTP 400; the request itself
+	// is malformed and retrying unchanged will not help.
+	BadRequest
+	// ServerError means the provider returned a 5xx other than "overloaded".
+	ServerError
+	// Overloaded means the provider is temporarily over capacity (e.g.
+	// Anthropic's 529 "overloaded_error").
+	Overloaded
+	// ContextLength means the request exceeded the model's context window.
+	ContextLength
+	// ContentFilter means the provider refused the request on safety/policy
+	// grounds; retrying unchanged will not help.
+	ContentFilter
+	// Network means the error occurred before a response was received
+	// (timeouts, connection resets, DNS failures).
+	Network
+)
+
+// String returns a lowercase, human-readable name for k.
+func (k Kind) String() string {
+	switch k {
+	case RateLimited:
+		return "rate_limited"
+	case Unauthorized:
+		return "unauthorized"
+	case BadRequest:
+		return "bad_request"
+	case ServerError:
+		return "server_error"
+	case Overloaded:
+		return "overloaded"
+	case ContextLength:
+		return "context_length"
+	case ContentFilter:
+		return "content_filter"
+	case Network:
+		return "network"
+	default:
+		return "unknown"
+	}
+}
+
+// Retryable reports whether errors of kind k are generally worth retrying.
+func (k Kind) Retryable() bool {
+	switch k {
+	case RateLimited, ServerError, Overloaded, Network:
+		return true
+	default:
+		return false
+	}
+}
+
+// Classify inspects err against each provider SDK's typed error
+// (*anthropic.Error, *openai.APIError, *googleapi.Error) and returns the
+// canonical Kind. Errors that don't match a known typed error fall back to
+// a conservative message-based classification so wrapped transport errors
+// and errors from providers without a typed binding still route correctly.
+func Classify(err error) Kind {
+	if err == nil {
+		return Unknown
+	}
+
+	var anthropicErr *anthropic.Error
+	if errors.As(err, &anthropicErr) {
+		return classifyAnthropic(anthropicErr)
+	}
+
+	var openaiErr *openai.APIError
+	if errors.As(err, &openaiErr) {
+		return classifyStatusCode(openaiErr.HTTPStatusCode, openaiErr.Message)
+	}
+
+	var googleErr *googleapi.Error
+	if errors.As(err, &googleErr) {
+		return classifyStatusCode(googleErr.Code, googleErr.Message)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return Network
+	}
+
+	// Fallback for errors that don't carry one of the typed SDK errors above
+	// (wrapped transport failures, test doubles, provider SDKs we don't have
+	// a typed binding for yet). Typed classification above always takes
+	// precedence when available.
+	return classifyByMessage(err.Error())
+}
+
+func classifyByMessage(message string) Kind {
+	msg := strings.ToLower(message)
+
+	switch {
+	case strings.Contains(msg, "401") || strings.Contains(msg, "403") ||
+		strings.Contains(msg, "unauthorized") || strings.Contains(msg, "forbidden"):
+		return Unauthorized
+	case strings.Contains(msg, "429") || strings.Contains(msg, "rate limit"):
+		return RateLimited
+	case strings.Contains(msg, "overloaded"):
+		return Overloaded
+	case strings.Contains(msg, "context_length") || strings.Contains(msg, "maximum context"):
+		return ContextLength
+	case strings.Contains(msg, "content_filter") || strings.Contains(msg, "safety"):
+		return ContentFilter
+	case strings.Contains(msg, "connection reset") || strings.Contains(msg, "connection refused") ||
+		strings.Contains(msg, "no such host") || strings.Contains(msg, "timeout"):
+		return Network
+	case strings.Contains(msg, "500") || strings.Contains(msg, "502") || strings.Contains(msg, "503"):
+		return ServerError
+	default:
+		return Unknown
+	}
+}
+
+func classifyAnthropic(err *anthropic.Error) Kind {
+	// Anthropic uses 529 for "overloaded_error", which is not a standard
+	// HTTP status most libraries recognize as a server error.
+	if err.StatusCode == 529 {
+		return Overloaded
+	}
+	// RawJSON is used instead of Error() here: Error() formats in the
+	// request/response, which the SDK always populates in practice but which
+	// isn't guaranteed by the type itself, so calling it on an incompletely
+	// constructed *anthropic.Error (e.g. a test double) can panic.
+	return classifyStatusCode(err.StatusCode, err.RawJSON())
+}
+
+func classifyStatusCode(statusCode int, message string) Kind {
+	lowerMsg := strings.ToLower(message)
+
+	switch {
+	case statusCode == 401 || statusCode == 403:
+		return Unauthorized
+	case statusCode == 429:
+		return RateLimited
+	case strings.Contains(lowerMsg, "content_filter") || strings.Contains(lowerMsg, "safety"):
+		return ContentFilter
+	case strings.Contains(lowerMsg, "context_length") || strings.Contains(lowerMsg, "maximum context") || (statusCode == 400 && strings.Contains(lowerMsg, "context")):
+		return ContextLength
+	case statusCode == 400:
+		return BadRequest
+	case statusCode >= 500:
+		return ServerError
+	default:
+		return Unknown
+	}
+}
+
+// RetryAfter extracts a Retry-After duration from a rate-limit error's
+// response headers, if the underlying SDK error exposes them. It returns
+// false if no Retry-After header was present or could not be parsed.
+func RetryAfter(err error) (time.Duration, bool) {
+	var anthropicErr *anthropic.Error
+	if errors.As(err, &anthropicErr) && anthropicErr.Response != nil {
+		return parseRetryAfter(anthropicErr.Response.Header)
+	}
+
+	// go-openai's APIError does not expose the response headers, so a
+	// Retry-After for OpenAI rate limits can't be recovered here; callers
+	// fall back to exponential backoff for that provider.
+
+	var googleErr *googleapi.Error
+	if errors.As(err, &googleErr) {
+		return parseRetryAfter(googleErr.Header)
+	}
+
+	return 0, false
+}
+
+func parseRetryAfter(h http.Header) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,202 @@
+package errkind_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/ownlingo/ownlingo/translator/errkind"
+	"github.com/sashabaranov/go-openai"
+	"google.golang.org/api/googleapi"
+)
+
+func TestKindString(t *testing.T) {
+	tests := []struct {
+		kind errkind.Kind
+		want string
+	}{
+		{errkind.RateLimited, "rate_limited"},
+		{errkind.Unauthorized, "unauthorized"},
+		{errkind.ServerError, "server_error"},
+		{errkind.Overloaded, "overloaded"},
+		{errkind.Unknown, "unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.kind.String(); got != tt.want {
+			t.Errorf("Kind(%d).String() = %q, want %q", tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestKindRetryable(t *testing.T) {
+	tests := []struct {
+		kind errkind.Kind
+		want bool
+	}{
+		{errkind.RateLimited, true},
+		{errkind.ServerError, true},
+		{errkind.Overloaded, true},
+		{errkind.Network, true},
+		{errkind.Unauthorized, false},
+		{errkind.BadRequest, false},
+		{errkind.ContextLength, false},
+		{errkind.ContentFilter, false},
+		{errkind.Unknown, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.kind.Retryable(); got != tt.want {
+			t.Errorf("%s.Retryable() = %v, want %v", tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestClassifyUnknownForPlainError(t *testing.T) {
+	if got := errkind.Classify(errors.New("something went wrong")); got != errkind.Unknown {
+		t.Errorf("expected Unknown for a plain error, got %s", got)
+	}
+}
+
+func TestClassifyNetworkError(t *testing.T) {
+	if got := errkind.Classify(errors.New("dial tcp: connection refused")); got != errkind.Network {
+		t.Errorf("expected Network for a connection-refused error, got %s", got)
+	}
+}
+
+func TestRetryAfterNoneForPlainError(t *testing.T) {
+	if _, ok := errkind.RetryAfter(errors.New("boom")); ok {
+		t.Error("expected no Retry-After for a plain error")
+	}
+}
+
+func TestClassifyAnthropicError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		message    string
+		want       errkind.Kind
+	}{
+		{"unauthorized", http.StatusUnauthorized, "", errkind.Unauthorized},
+		{"forbidden", http.StatusForbidden, "", errkind.Unauthorized},
+		{"rate limited", http.StatusTooManyRequests, "", errkind.RateLimited},
+		{"bad request", http.StatusBadRequest, "", errkind.BadRequest},
+		{"context length", http.StatusBadRequest, "prompt is too long: maximum context length exceeded", errkind.ContextLength},
+		{"overloaded", 529, "overloaded_error", errkind.Overloaded},
+		{"server error", http.StatusInternalServerError, "", errkind.ServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Request and Response are intentionally left nil: classifyAnthropic
+			// must not call err.Error(), which dereferences both unconditionally
+			// and would panic on a test double like this one. The body is fed
+			// through UnmarshalJSON, the same path the SDK itself uses to
+			// populate RawJSON, rather than poking an unexported field.
+			err := &anthropic.Error{StatusCode: tt.statusCode}
+			if tt.message != "" {
+				body, marshalErr := json.Marshal(map[string]string{"message": tt.message})
+				if marshalErr != nil {
+					t.Fatalf("failed to build response body: %v", marshalErr)
+				}
+				if unmarshalErr := err.UnmarshalJSON(body); unmarshalErr != nil {
+					t.Fatalf("failed to populate anthropic.Error: %v", unmarshalErr)
+				}
+			}
+
+			if got := errkind.Classify(err); got != tt.want {
+				t.Errorf("Classify(anthropic.Error{StatusCode: %d, Message: %q}) = %s, want %s", tt.statusCode, tt.message, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyAnthropicErrorWrapped(t *testing.T) {
+	err := fmt.Errorf("anthropic request failed: %w", &anthropic.Error{StatusCode: http.StatusTooManyRequests})
+	if got := errkind.Classify(err); got != errkind.RateLimited {
+		t.Errorf("expected RateLimited for a wrapped anthropic.Error, got %s", got)
+	}
+}
+
+func TestRetryAfterFromAnthropicError(t *testing.T) {
+	err := &anthropic.Error{
+		StatusCode: http.StatusTooManyRequests,
+		Response: &http.Response{
+			Header: http.Header{"Retry-After": []string{"30"}},
+		},
+	}
+
+	d, ok := errkind.RetryAfter(err)
+	if !ok {
+		t.Fatal("expected a Retry-After duration")
+	}
+	if d.Seconds() != 30 {
+		t.Errorf("expected 30s, got %v", d)
+	}
+}
+
+func TestClassifyOpenAIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		message    string
+		want       errkind.Kind
+	}{
+		{"unauthorized", http.StatusUnauthorized, "invalid api key", errkind.Unauthorized},
+		{"rate limited", http.StatusTooManyRequests, "rate limit reached", errkind.RateLimited},
+		{"context length", http.StatusBadRequest, "this model's maximum context length is 4096 tokens", errkind.ContextLength},
+		{"bad request", http.StatusBadRequest, "invalid request", errkind.BadRequest},
+		{"content filter", http.StatusBadRequest, "content_filter triggered", errkind.ContentFilter},
+		{"server error", http.StatusServiceUnavailable, "service unavailable", errkind.ServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &openai.APIError{HTTPStatusCode: tt.statusCode, Message: tt.message}
+			if got := errkind.Classify(err); got != tt.want {
+				t.Errorf("Classify(openai.APIError{%d, %q}) = %s, want %s", tt.statusCode, tt.message, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyGoogleAPIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		message    string
+		want       errkind.Kind
+	}{
+		{"unauthorized", http.StatusForbidden, "permission denied", errkind.Unauthorized},
+		{"rate limited", http.StatusTooManyRequests, "quota exceeded", errkind.RateLimited},
+		{"bad request", http.StatusBadRequest, "invalid argument", errkind.BadRequest},
+		{"server error", http.StatusInternalServerError, "internal error", errkind.ServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &googleapi.Error{Code: tt.statusCode, Message: tt.message}
+			if got := errkind.Classify(err); got != tt.want {
+				t.Errorf("Classify(googleapi.Error{%d, %q}) = %s, want %s", tt.statusCode, tt.message, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryAfterFromGoogleAPIError(t *testing.T) {
+	err := &googleapi.Error{
+		Code:   http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": []string{"15"}},
+	}
+
+	d, ok := errkind.RetryAfter(err)
+	if !ok {
+		t.Fatal("expected a Retry-After duration")
+	}
+	if d.Seconds() != 15 {
+		t.Errorf("expected 15s, got %v", d)
+	}
+}
@@ -0,0 +1,94 @@
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ownlingo/ownlingo/translator/errkind"
+)
+
+// Sentinel errors that providers, fallback.Chain, and router.Router wrap
+// their failures in, so callers can distinguish failure classes with
+// errors.Is/errors.As instead of matching substrings in error messages.
+var (
+	// ErrRateLimited means a provider reported that it is rate limiting
+	// requests.
+	ErrRateLimited = errors.New("translator: rate limited")
+	// ErrAuthFailed means a provider rejected the request's credentials.
+	ErrAuthFailed = errors.New("translator: authentication failed")
+	// ErrContentBlocked means a provider refused the request on safety or
+	// content-policy grounds.
+	ErrContentBlocked = errors.New("translator: content blocked by provider policy")
+	// ErrUnsupportedLanguage means no configured provider supports the
+	// requested source/target language pair.
+	ErrUnsupportedLanguage = errors.New("translator: unsupported language pair")
+	// ErrAllProvidersFailed means every provider in a fallback chain or
+	// router failed to complete a request.
+	ErrAllProvidersFailed = errors.New("translator: all providers failed")
+	// ErrNonRetryable means a provider's error was classified as not worth
+	// retrying but doesn't match one of the more specific sentinels above
+	// (e.g. a malformed request or an exhausted context window).
+	ErrNonRetryable = errors.New("translator: non-retryable error")
+)
+
+// SentinelFor returns the package sentinel error that best matches kind, or
+// nil if kind has no specific sentinel.
+func SentinelFor(kind errkind.Kind) error {
+	switch kind {
+	case errkind.RateLimited:
+		return ErrRateLimited
+	case errkind.Unauthorized:
+		return ErrAuthFailed
+	case errkind.ContentFilter:
+		return ErrContentBlocked
+	default:
+		return nil
+	}
+}
+
+// WrapKind wraps err so errors.Is reports true against the sentinel that
+// matches kind (ErrRateLimited, ErrAuthFailed, ErrContentBlocked). If kind
+// has no specific sentinel and isn't retryable, err is wrapped in
+// ErrNonRetryable instead. Retryable kinds with no specific sentinel (e.g.
+// ServerError, Overloaded, Network) are returned unwrapped.
+func WrapKind(err error, kind errkind.Kind) error {
+	if sentinel := SentinelFor(kind); sentinel != nil {
+		return fmt.Errorf("%w: %w", sentinel, err)
+	}
+	if !kind.Retryable() {
+		return fmt.Errorf("%w: %w", ErrNonRetryable, err)
+	}
+	return err
+}
+
+// AllProvidersFailedError aggregates the per-provider errors from a failed
+// Translate/TranslateBatch/TranslateStream call across a fallback.Chain or
+// router.Router. It wraps ErrAllProvidersFailed alongside every per-provider
+// error, so errors.Is(err, translator.ErrRateLimited) is true if any
+// provider's failure was a rate limit, and likewise for every other
+// sentinel.
+type AllProvidersFailedError struct {
+	errs []error
+}
+
+// JoinProviderErrors builds an AllProvidersFailedError from the per-provider
+// errors collected while trying each candidate in turn. errs must be
+// non-empty.
+func JoinProviderErrors(errs []error) error {
+	return &AllProvidersFailedError{errs: errs}
+}
+
+func (e *AllProvidersFailedError) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%s: %s", ErrAllProvidersFailed, strings.Join(msgs, "; "))
+}
+
+// Unwrap exposes ErrAllProvidersFailed and every per-provider error to
+// errors.Is/errors.As, per Go's multi-error Unwrap() []error convention.
+func (e *AllProvidersFailedError) Unwrap() []error {
+	return append([]error{ErrAllProvidersFailed}, e.errs...)
+}
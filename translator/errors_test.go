@@ -0,0 +1,107 @@
+package translator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/errkind"
+)
+
+func TestSentinelFor(t *testing.T) {
+	tests := []struct {
+		name string
+		kind errkind.Kind
+		want error
+	}{
+		{"rate limited", errkind.RateLimited, translator.ErrRateLimited},
+		{"unauthorized", errkind.Unauthorized, translator.ErrAuthFailed},
+		{"content filter", errkind.ContentFilter, translator.ErrContentBlocked},
+		{"server error has no specific sentinel", errkind.ServerError, nil},
+		{"unknown has no specific sentinel", errkind.Unknown, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := translator.SentinelFor(tt.kind)
+			if got != tt.want {
+				t.Errorf("SentinelFor(%v) = %v, want %v", tt.kind, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrapKind(t *testing.T) {
+	base := errors.New("provider says no")
+
+	tests := []struct {
+		name      string
+		kind      errkind.Kind
+		wantIs    []error
+		wantNotIs []error
+	}{
+		{
+			name:   "rate limited wraps ErrRateLimited",
+			kind:   errkind.RateLimited,
+			wantIs: []error{translator.ErrRateLimited, base},
+		},
+		{
+			name:   "unauthorized wraps ErrAuthFailed",
+			kind:   errkind.Unauthorized,
+			wantIs: []error{translator.ErrAuthFailed, base},
+		},
+		{
+			name:   "content filter wraps ErrContentBlocked",
+			kind:   errkind.ContentFilter,
+			wantIs: []error{translator.ErrContentBlocked, base},
+		},
+		{
+			name:   "bad request has no sentinel but is non-retryable",
+			kind:   errkind.BadRequest,
+			wantIs: []error{translator.ErrNonRetryable, base},
+		},
+		{
+			name:      "server error is retryable and passes through unwrapped",
+			kind:      errkind.ServerError,
+			wantIs:    []error{base},
+			wantNotIs: []error{translator.ErrNonRetryable, translator.ErrRateLimited},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := translator.WrapKind(base, tt.kind)
+
+			for _, want := range tt.wantIs {
+				if !errors.Is(got, want) {
+					t.Errorf("expected errors.Is(got, %v) to be true", want)
+				}
+			}
+			for _, notWant := range tt.wantNotIs {
+				if errors.Is(got, notWant) {
+					t.Errorf("expected errors.Is(got, %v) to be false", notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestJoinProviderErrors(t *testing.T) {
+	rateLimitErr := translator.WrapKind(errors.New("openai: too many requests"), errkind.RateLimited)
+	authErr := translator.WrapKind(errors.New("anthropic: invalid key"), errkind.Unauthorized)
+
+	joined := translator.JoinProviderErrors([]error{rateLimitErr, authErr})
+
+	if !errors.Is(joined, translator.ErrAllProvidersFailed) {
+		t.Error("expected errors.Is(joined, ErrAllProvidersFailed) to be true")
+	}
+	if !errors.Is(joined, translator.ErrRateLimited) {
+		t.Error("expected errors.Is(joined, ErrRateLimited) to be true since one provider hit a rate limit")
+	}
+	if !errors.Is(joined, translator.ErrAuthFailed) {
+		t.Error("expected errors.Is(joined, ErrAuthFailed) to be true since one provider failed auth")
+	}
+	if errors.Is(joined, translator.ErrContentBlocked) {
+		t.Error("expected errors.Is(joined, ErrContentBlocked) to be false; no provider reported that")
+	}
+}
@@ -3,12 +3,14 @@ package fallback
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/ownlingo/ownlingo/translator"
 )
 
 // Chain implements a fallback chain of AI translators
 type Chain struct {
+	mu        sync.RWMutex
 	providers []translator.AITranslator
 }
 
@@ -24,51 +26,152 @@ func NewChain(providers ...translator.AITranslator) *Chain {
 	}
 }
 
+// SetProviders atomically replaces the chain's provider list. A
+// Translate/TranslateBatch/TranslateStream call already in progress keeps
+// using the provider list it started with; only calls that begin after
+// SetProviders returns see the new one.
+func (c *Chain) SetProviders(providers ...translator.AITranslator) {
+	if len(providers) == 0 {
+		panic("at least one provider is required")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.providers = providers
+}
+
+// snapshot returns the current provider list under a read lock. Callers
+// hold onto the returned slice for the rest of their call instead of
+// re-reading c.providers, so a concurrent SetProviders can't change the
+// list out from under an in-flight request.
+func (c *Chain) snapshot() []translator.AITranslator {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.providers
+}
+
 // Name returns the name of the chain (primary provider name)
 func (c *Chain) Name() string {
-	return fmt.Sprintf("fallback-chain(%s)", c.providers[0].Name())
+	providers := c.snapshot()
+	return fmt.Sprintf("fallback-chain(%s)", providers[0].Name())
 }
 
 // Translate attempts translation with fallback to secondary providers on failure
 func (c *Chain) Translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
-	var lastErr error
+	providers := c.snapshot()
+	var errs []error
 
-	for i, provider := range c.providers {
+	for i, provider := range providers {
 		resp, err := provider.Translate(ctx, req)
 		if err == nil {
 			return resp, nil
 		}
 
-		lastErr = fmt.Errorf("provider %s (%d/%d) failed: %w",
-			provider.Name(), i+1, len(c.providers), err)
+		errs = append(errs, fmt.Errorf("provider %s (%d/%d) failed: %w",
+			provider.Name(), i+1, len(providers), err))
+	}
+
+	return nil, translator.JoinProviderErrors(errs)
+}
+
+// TranslateStream attempts a streaming translation with fallback to secondary
+// providers, but only while the chosen provider has not yet delivered any
+// text. Once a provider starts streaming bytes, the chain commits to it for
+// the rest of the response so consumers see an unbroken text stream.
+func (c *Chain) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	providers := c.snapshot()
+	var errs []error
+
+	for i, provider := range providers {
+		stream, err := provider.TranslateStream(ctx, req)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("provider %s (%d/%d) failed: %w",
+				provider.Name(), i+1, len(providers), err))
+			continue
+		}
 
-		// If this is not the last provider, continue to next
-		if i < len(c.providers)-1 {
+		first, ok := <-stream
+		if !ok || first.Err != nil {
+			if ok {
+				errs = append(errs, fmt.Errorf("provider %s (%d/%d) failed before streaming any text: %w",
+					provider.Name(), i+1, len(providers), first.Err))
+			} else {
+				errs = append(errs, fmt.Errorf("provider %s (%d/%d) closed its stream before streaming any text",
+					provider.Name(), i+1, len(providers)))
+			}
 			continue
 		}
+
+		// Bytes have started flowing: commit to this provider and relay the
+		// rest of the stream unchanged on a channel owned by the chain.
+		out := make(chan translator.StreamChunk)
+		go func() {
+			defer close(out)
+			out <- first
+			for chunk := range stream {
+				out <- chunk
+			}
+		}()
+		return out, nil
 	}
 
-	return nil, fmt.Errorf("all providers failed, last error: %w", lastErr)
+	return nil, translator.JoinProviderErrors(errs)
 }
 
-// TranslateBatch translates multiple texts with fallback support
-func (c *Chain) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest) ([]*translator.TranslationResponse, error) {
-	var lastErr error
+// TranslateBatch translates multiple texts with fallback support. Requests
+// that fail against one provider are retried against the next rather than
+// failing the whole batch; only requests that fail against every provider
+// come back with an error. opts is passed unchanged to each provider's
+// TranslateBatch, so opts.OnProgress reports each provider's round
+// separately, with that round's request count as total.
+func (c *Chain) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	results := make([]translator.BatchResult, len(reqs))
+
+	remaining := reqs
+	remainingIdx := make([]int, len(reqs))
+	for i := range remainingIdx {
+		remainingIdx[i] = i
+	}
 
-	for i, provider := range c.providers {
-		responses, err := provider.TranslateBatch(ctx, reqs)
-		if err == nil {
-			return responses, nil
-		}
+	errsByIdx := make([][]error, len(reqs))
 
-		lastErr = fmt.Errorf("provider %s (%d/%d) failed: %w",
-			provider.Name(), i+1, len(c.providers), err)
+	providers := c.snapshot()
+	for i, provider := range providers {
+		if len(remaining) == 0 {
+			break
+		}
 
-		// If this is not the last provider, continue to next
-		if i < len(c.providers)-1 {
+		batchResults, err := provider.TranslateBatch(ctx, remaining, opts)
+		if err != nil {
+			wrapped := fmt.Errorf("provider %s (%d/%d) failed: %w",
+				provider.Name(), i+1, len(providers), err)
+			for _, idx := range remainingIdx {
+				errsByIdx[idx] = append(errsByIdx[idx], wrapped)
+			}
 			continue
 		}
+
+		var nextRemaining []*translator.TranslationRequest
+		var nextRemainingIdx []int
+		for j, res := range batchResults {
+			origIdx := remainingIdx[j]
+			if res.Err != nil {
+				errsByIdx[origIdx] = append(errsByIdx[origIdx], fmt.Errorf("provider %s (%d/%d) failed on one request: %w",
+					provider.Name(), i+1, len(providers), res.Err))
+				nextRemaining = append(nextRemaining, remaining[j])
+				nextRemainingIdx = append(nextRemainingIdx, origIdx)
+				continue
+			}
+			results[origIdx] = res
+		}
+
+		remaining = nextRemaining
+		remainingIdx = nextRemainingIdx
+	}
+
+	for _, idx := range remainingIdx {
+		results[idx] = translator.BatchResult{Err: translator.JoinProviderErrors(errsByIdx[idx])}
 	}
 
-	return nil, fmt.Errorf("all providers failed for batch, last error: %w", lastErr)
+	return results, nil
 }
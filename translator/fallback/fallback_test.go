@@ -6,14 +6,20 @@ import (
 	"testing"
 
 	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/errkind"
 	"github.com/ownlingo/ownlingo/translator/fallback"
 )
 
 // Mock translator for testing
 type mockTranslator struct {
-	name      string
-	shouldErr bool
-	errMsg    string
+	name         string
+	shouldErr    bool
+	errMsg       string
+	translateErr error           // if set, returned from Translate instead of errors.New(errMsg)
+	failTexts    map[string]bool // in TranslateBatch, only fail requests whose Text matches
+	streamChunks []string        // text deltas to emit before erroring/completing
+	streamErr    bool            // error after emitting streamChunks
+	streamErrMsg string
 }
 
 func (m *mockTranslator) Name() string {
@@ -22,6 +28,9 @@ func (m *mockTranslator) Name() string {
 
 func (m *mockTranslator) Translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
 	if m.shouldErr {
+		if m.translateErr != nil {
+			return nil, m.translateErr
+		}
 		return nil, errors.New(m.errMsg)
 	}
 
@@ -32,21 +41,51 @@ func (m *mockTranslator) Translate(ctx context.Context, req *translator.Translat
 	}, nil
 }
 
-func (m *mockTranslator) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest) ([]*translator.TranslationResponse, error) {
-	if m.shouldErr {
-		return nil, errors.New(m.errMsg)
-	}
-
-	responses := make([]*translator.TranslationResponse, len(reqs))
+func (m *mockTranslator) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	results := make([]translator.BatchResult, len(reqs))
 	for i, req := range reqs {
-		responses[i] = &translator.TranslationResponse{
+		if m.shouldErr || m.failTexts[req.Text] {
+			results[i] = translator.BatchResult{Err: errors.New(m.errMsg)}
+			continue
+		}
+		results[i] = translator.BatchResult{Response: &translator.TranslationResponse{
 			TranslatedText: "translated: " + req.Text,
 			SourceText:     req.Text,
 			Provider:       m.name,
-		}
+		}}
 	}
 
-	return responses, nil
+	return results, nil
+}
+
+func (m *mockTranslator) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	if m.shouldErr {
+		return nil, errors.New(m.errMsg)
+	}
+
+	out := make(chan translator.StreamChunk)
+	go func() {
+		defer close(out)
+		for _, delta := range m.streamChunks {
+			out <- translator.StreamChunk{TextDelta: delta}
+		}
+
+		if m.streamErr {
+			out <- translator.StreamChunk{Err: errors.New(m.streamErrMsg)}
+			return
+		}
+
+		out <- translator.StreamChunk{
+			Done: true,
+			Response: &translator.TranslationResponse{
+				TranslatedText: "translated: " + req.Text,
+				SourceText:     req.Text,
+				Provider:       m.name,
+			},
+		}
+	}()
+
+	return out, nil
 }
 
 func TestNewChain(t *testing.T) {
@@ -157,6 +196,59 @@ func TestChainTranslateAllFail(t *testing.T) {
 	if !contains(err.Error(), "all providers failed") {
 		t.Errorf("expected 'all providers failed' in error, got: %v", err)
 	}
+
+	if !errors.Is(err, translator.ErrAllProvidersFailed) {
+		t.Error("expected errors.Is(err, translator.ErrAllProvidersFailed) to be true")
+	}
+}
+
+func TestChainTranslateAllFailIsFindsUnderlyingSentinels(t *testing.T) {
+	tests := []struct {
+		name         string
+		provider1Err error
+		provider2Err error
+		want         error
+	}{
+		{
+			name:         "rate limit on one provider surfaces through the join",
+			provider1Err: translator.WrapKind(errors.New("too many requests"), errkind.RateLimited),
+			provider2Err: translator.WrapKind(errors.New("invalid key"), errkind.Unauthorized),
+			want:         translator.ErrRateLimited,
+		},
+		{
+			name:         "auth failure on either provider surfaces through the join",
+			provider1Err: translator.WrapKind(errors.New("server exploded"), errkind.ServerError),
+			provider2Err: translator.WrapKind(errors.New("invalid key"), errkind.Unauthorized),
+			want:         translator.ErrAuthFailed,
+		},
+		{
+			name:         "content block on either provider surfaces through the join",
+			provider1Err: translator.WrapKind(errors.New("server exploded"), errkind.ServerError),
+			provider2Err: translator.WrapKind(errors.New("refused"), errkind.ContentFilter),
+			want:         translator.ErrContentBlocked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider1 := &mockTranslator{name: "provider1", shouldErr: true, errMsg: tt.provider1Err.Error()}
+			provider1.translateErr = tt.provider1Err
+			provider2 := &mockTranslator{name: "provider2", shouldErr: true, errMsg: tt.provider2Err.Error()}
+			provider2.translateErr = tt.provider2Err
+
+			chain := fallback.NewChain(provider1, provider2)
+
+			req := &translator.TranslationRequest{Text: "Hello"}
+			_, err := chain.Translate(context.Background(), req)
+			if err == nil {
+				t.Fatal("expected error when all providers fail")
+			}
+
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected errors.Is(err, %v) to be true, got: %v", tt.want, err)
+			}
+		})
+	}
 }
 
 func TestChainTranslateBatchSuccess(t *testing.T) {
@@ -168,18 +260,21 @@ func TestChainTranslateBatchSuccess(t *testing.T) {
 		{Text: "World", SourceLanguage: "en", TargetLanguage: "es"},
 	}
 
-	responses, err := chain.TranslateBatch(context.Background(), reqs)
+	results, err := chain.TranslateBatch(context.Background(), reqs, nil)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
-	if len(responses) != 2 {
-		t.Fatalf("expected 2 responses, got %d", len(responses))
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
 	}
 
-	for i, resp := range responses {
-		if resp.Provider != "test-provider" {
-			t.Errorf("response %d: expected provider 'test-provider', got %q", i, resp.Provider)
+	for i, res := range results {
+		if res.Err != nil {
+			t.Fatalf("result %d: unexpected error: %v", i, res.Err)
+		}
+		if res.Response.Provider != "test-provider" {
+			t.Errorf("result %d: expected provider 'test-provider', got %q", i, res.Response.Provider)
 		}
 	}
 }
@@ -200,14 +295,217 @@ func TestChainTranslateBatchFallback(t *testing.T) {
 		{Text: "Hello", SourceLanguage: "en", TargetLanguage: "es"},
 	}
 
-	responses, err := chain.TranslateBatch(context.Background(), reqs)
+	results, err := chain.TranslateBatch(context.Background(), reqs, nil)
 	if err != nil {
 		t.Fatalf("expected no error after fallback, got %v", err)
 	}
 
-	if responses[0].Provider != "provider2" {
-		t.Errorf("expected provider 'provider2', got %q", responses[0].Provider)
+	if results[0].Err != nil {
+		t.Fatalf("expected no error after fallback, got %v", results[0].Err)
+	}
+	if results[0].Response.Provider != "provider2" {
+		t.Errorf("expected provider 'provider2', got %q", results[0].Response.Provider)
+	}
+}
+
+func TestChainTranslateBatchPartialFailureOnlyRetriesFailedItems(t *testing.T) {
+	provider1 := &mockTranslator{
+		name:      "provider1",
+		errMsg:    "provider1 rejected this one",
+		failTexts: map[string]bool{"bad": true},
 	}
+	provider2 := &mockTranslator{name: "provider2"}
+
+	chain := fallback.NewChain(provider1, provider2)
+
+	reqs := []*translator.TranslationRequest{
+		{Text: "good"},
+		{Text: "bad"},
+	}
+
+	results, err := chain.TranslateBatch(context.Background(), reqs, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if results[0].Err != nil || results[0].Response.Provider != "provider1" {
+		t.Errorf("expected request 0 to succeed against provider1, got %+v", results[0])
+	}
+	if results[1].Err != nil || results[1].Response.Provider != "provider2" {
+		t.Errorf("expected request 1 to fall back to provider2, got %+v", results[1])
+	}
+}
+
+func TestChainTranslateStreamSuccess(t *testing.T) {
+	provider := &mockTranslator{name: "test-provider", streamChunks: []string{"Hola", ", ", "mundo"}}
+	chain := fallback.NewChain(provider)
+
+	req := &translator.TranslationRequest{Text: "Hello, world", SourceLanguage: "en", TargetLanguage: "es"}
+
+	stream, err := chain.TranslateStream(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var deltas []string
+	var final *translator.TranslationResponse
+	for chunk := range stream {
+		if chunk.Err != nil {
+			t.Fatalf("unexpected chunk error: %v", chunk.Err)
+		}
+		if chunk.Done {
+			final = chunk.Response
+			continue
+		}
+		deltas = append(deltas, chunk.TextDelta)
+	}
+
+	if len(deltas) != 3 {
+		t.Fatalf("expected 3 text deltas, got %d", len(deltas))
+	}
+
+	if final == nil || final.TranslatedText != "translated: Hello, world" {
+		t.Fatalf("unexpected final response: %+v", final)
+	}
+}
+
+func TestChainTranslateStreamFallbackBeforeBytes(t *testing.T) {
+	provider1 := &mockTranslator{name: "provider1", shouldErr: true, errMsg: "provider1 unavailable"}
+	provider2 := &mockTranslator{name: "provider2", streamChunks: []string{"Hola"}}
+
+	chain := fallback.NewChain(provider1, provider2)
+
+	req := &translator.TranslationRequest{Text: "Hello", SourceLanguage: "en", TargetLanguage: "es"}
+
+	stream, err := chain.TranslateStream(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected no error after fallback, got %v", err)
+	}
+
+	var gotText bool
+	for chunk := range stream {
+		if chunk.TextDelta == "Hola" {
+			gotText = true
+		}
+	}
+
+	if !gotText {
+		t.Error("expected to receive text from provider2 after provider1 failed to start")
+	}
+}
+
+func TestChainTranslateStreamNoFallbackAfterBytes(t *testing.T) {
+	provider1 := &mockTranslator{name: "provider1", streamChunks: []string{"partial"}, streamErr: true, streamErrMsg: "dropped connection"}
+	provider2 := &mockTranslator{name: "provider2", streamChunks: []string{"should not be used"}}
+
+	chain := fallback.NewChain(provider1, provider2)
+
+	req := &translator.TranslationRequest{Text: "Hello", SourceLanguage: "en", TargetLanguage: "es"}
+
+	stream, err := chain.TranslateStream(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var sawErr bool
+	for chunk := range stream {
+		if chunk.Err != nil {
+			sawErr = true
+		}
+		if chunk.TextDelta == "should not be used" {
+			t.Fatal("chain should not have fallen back after bytes were already streamed")
+		}
+	}
+
+	if !sawErr {
+		t.Error("expected the mid-stream error from provider1 to be surfaced, not masked by fallback")
+	}
+}
+
+// blockingMockTranslator blocks inside Translate until release is closed,
+// signaling on started first so a test can be sure the call is in flight
+// before it mutates shared state (like calling SetProviders).
+type blockingMockTranslator struct {
+	name    string
+	started chan struct{}
+	release chan struct{}
+}
+
+func (m *blockingMockTranslator) Name() string { return m.name }
+
+func (m *blockingMockTranslator) Translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+	close(m.started)
+	<-m.release
+	return &translator.TranslationResponse{
+		TranslatedText: "translated: " + req.Text,
+		SourceText:     req.Text,
+		Provider:       m.name,
+	}, nil
+}
+
+func (m *blockingMockTranslator) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *blockingMockTranslator) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestChainSetProvidersDoesNotAffectInFlightTranslate(t *testing.T) {
+	oldProvider := &blockingMockTranslator{
+		name:    "old-provider",
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	newProvider := &mockTranslator{name: "new-provider"}
+
+	chain := fallback.NewChain(oldProvider)
+
+	req := &translator.TranslationRequest{Text: "Hello", SourceLanguage: "en", TargetLanguage: "es"}
+
+	type result struct {
+		resp *translator.TranslationResponse
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		resp, err := chain.Translate(context.Background(), req)
+		done <- result{resp, err}
+	}()
+
+	<-oldProvider.started
+	chain.SetProviders(newProvider)
+
+	// A call that starts after SetProviders sees the new provider list.
+	resp, err := chain.Translate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if resp.Provider != "new-provider" {
+		t.Errorf("expected new call to use 'new-provider', got %q", resp.Provider)
+	}
+
+	close(oldProvider.release)
+
+	r := <-done
+	if r.err != nil {
+		t.Fatalf("expected no error, got %v", r.err)
+	}
+	if r.resp.Provider != "old-provider" {
+		t.Errorf("expected in-flight call to finish against 'old-provider', got %q", r.resp.Provider)
+	}
+}
+
+func TestChainSetProvidersPanicsOnEmpty(t *testing.T) {
+	chain := fallback.NewChain(&mockTranslator{name: "provider1"})
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic when calling SetProviders with no providers")
+		}
+	}()
+
+	chain.SetProviders()
 }
 
 func contains(s, substr string) bool {
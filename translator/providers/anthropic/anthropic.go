@@ -3,19 +3,24 @@ package anthropic
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/anthropics/anthropic-sdk-go"
 	"github.com/anthropics/anthropic-sdk-go/option"
 	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/clock"
+	"github.com/ownlingo/ownlingo/translator/errkind"
 	"github.com/ownlingo/ownlingo/translator/ratelimit"
 	"github.com/ownlingo/ownlingo/translator/retry"
 )
 
 // Provider implements the AITranslator interface for Anthropic
 type Provider struct {
-	client      anthropic.Client
-	model       string
+	mu     sync.RWMutex
+	client anthropic.Client
+	model  string
+
 	rateLimiter *ratelimit.Limiter
 	retryConfig *retry.Config
 }
@@ -27,6 +32,10 @@ type Config struct {
 	TPM         int // Tokens per minute
 	RPM         int // Requests per minute
 	RetryConfig *retry.Config
+
+	// Clock backs rate limiting and retry backoff. Defaults to the real wall
+	// clock when nil; tests can inject a clock.Fake for deterministic timing.
+	Clock clock.Clock
 }
 
 // DefaultConfig returns default Anthropic configuration
@@ -48,12 +57,30 @@ func NewProvider(config *Config) *Provider {
 
 	client := anthropic.NewClient(option.WithAPIKey(config.APIKey))
 
+	clk := config.Clock
+	if clk == nil {
+		clk = clock.Real
+	}
+
 	return &Provider{
 		client:      client,
 		model:       config.Model,
-		rateLimiter: ratelimit.NewLimiter(config.TPM, config.RPM),
-		retryConfig: config.RetryConfig,
+		rateLimiter: ratelimit.NewLimiterWithClock(config.TPM, config.RPM, clk),
+		retryConfig: withClock(config.RetryConfig, clk),
+	}
+}
+
+// withClock returns retryConfig with its Clock set to clk if retryConfig is
+// non-nil and doesn't already specify one, leaving the caller's Config
+// untouched. A nil retryConfig is returned as-is; retry.Do applies its own
+// default (the real wall clock) in that case.
+func withClock(retryConfig *retry.Config, clk clock.Clock) *retry.Config {
+	if retryConfig == nil || retryConfig.Clock != nil {
+		return retryConfig
 	}
+	cfg := *retryConfig
+	cfg.Clock = clk
+	return &cfg
 }
 
 // Name returns the provider name
@@ -61,6 +88,25 @@ func (p *Provider) Name() string {
 	return "anthropic"
 }
 
+// Reload swaps in a new API key and model, and updates the rate limiter's
+// TPM/RPM, without disrupting any Translate/TranslateStream call already in
+// flight against the old client. cfg.Name is not checked here; the caller
+// (typically a reload.Manager) is responsible for routing configs to the
+// right provider.
+func (p *Provider) Reload(ctx context.Context, cfg translator.ProviderConfig) error {
+	client := anthropic.NewClient(option.WithAPIKey(cfg.APIKey))
+
+	p.mu.Lock()
+	p.client = client
+	p.model = cfg.Model
+	p.mu.Unlock()
+
+	p.rateLimiter.SetTPM(cfg.TPM)
+	p.rateLimiter.SetRPM(cfg.RPM)
+
+	return nil
+}
+
 // Translate translates a single text
 func (p *Provider) Translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
 	start := time.Now()
@@ -109,8 +155,12 @@ func (p *Provider) translate(ctx context.Context, req *translator.TranslationReq
 	fullPrompt := fmt.Sprintf("%s\n\nTranslate the following text from %s to %s:\n\n%s",
 		systemPrompt, req.SourceLanguage, req.TargetLanguage, req.Text)
 
-	message, err := p.client.Messages.New(ctx, anthropic.MessageNewParams{
-		Model:     anthropic.Model(p.model),
+	p.mu.RLock()
+	client, model := p.client, p.model
+	p.mu.RUnlock()
+
+	message, err := client.Messages.New(ctx, anthropic.MessageNewParams{
+		Model:     anthropic.Model(model),
 		MaxTokens: 4096,
 		Messages: []anthropic.MessageParam{
 			anthropic.NewUserMessage(anthropic.NewTextBlock(fullPrompt)),
@@ -118,11 +168,7 @@ func (p *Provider) translate(ctx context.Context, req *translator.TranslationReq
 	})
 
 	if err != nil {
-		// Check if error is retryable (429 or 500)
-		if isRetryableError(err) {
-			return nil, &retry.RetryableError{Err: err}
-		}
-		return nil, err
+		return nil, wrapProviderError(err)
 	}
 
 	if len(message.Content) == 0 {
@@ -132,7 +178,7 @@ func (p *Provider) translate(ctx context.Context, req *translator.TranslationReq
 	translatedText := message.Content[0].Text
 
 	// Calculate cost (approximate)
-	cost := calculateCost(p.model, int(message.Usage.InputTokens), int(message.Usage.OutputTokens))
+	cost := calculateCost(model, int(message.Usage.InputTokens), int(message.Usage.OutputTokens))
 
 	return &translator.TranslationResponse{
 		TranslatedText: translatedText,
@@ -149,26 +195,122 @@ func (p *Provider) translate(ctx context.Context, req *translator.TranslationReq
 	}, nil
 }
 
-// TranslateBatch translates multiple texts
-func (p *Provider) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest) ([]*translator.TranslationResponse, error) {
-	responses := make([]*translator.TranslationResponse, len(reqs))
+// TranslateStream translates a single text, streaming incremental chunks of
+// translated text as they arrive from Anthropic.
+func (p *Provider) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	start := time.Now()
 
-	for i, req := range reqs {
-		resp, err := p.Translate(ctx, req)
-		if err != nil {
-			return nil, fmt.Errorf("batch translate failed at index %d: %w", i, err)
-		}
-		responses[i] = resp
+	estimatedTokens := len(req.Text) / 4
+	if estimatedTokens < 100 {
+		estimatedTokens = 100
 	}
 
-	return responses, nil
+	if err := p.rateLimiter.Wait(ctx, estimatedTokens); err != nil {
+		return nil, err
+	}
+
+	systemPrompt := translator.SystemPrompt(req.PreserveHTML, req.PreserveLiquid)
+	fullPrompt := fmt.Sprintf("%s\n\nTranslate the following text from %s to %s:\n\n%s",
+		systemPrompt, req.SourceLanguage, req.TargetLanguage, req.Text)
+
+	p.mu.RLock()
+	client, model := p.client, p.model
+	p.mu.RUnlock()
+
+	stream := client.Messages.NewStreaming(ctx, anthropic.MessageNewParams{
+		Model:     anthropic.Model(model),
+		MaxTokens: 4096,
+		Messages: []anthropic.MessageParam{
+			anthropic.NewUserMessage(anthropic.NewTextBlock(fullPrompt)),
+		},
+	})
+
+	out := make(chan translator.StreamChunk)
+
+	go func() {
+		defer close(out)
+
+		var translatedText string
+		message := anthropic.Message{}
+
+		for stream.Next() {
+			event := stream.Current()
+			if err := message.Accumulate(event); err != nil {
+				out <- translator.StreamChunk{Err: fmt.Errorf("anthropic stream accumulate failed: %w", err)}
+				return
+			}
+
+			if event.Delta.Text != "" {
+				translatedText += event.Delta.Text
+				out <- translator.StreamChunk{TextDelta: event.Delta.Text}
+			}
+		}
+
+		if err := stream.Err(); err != nil {
+			out <- translator.StreamChunk{Err: wrapProviderError(fmt.Errorf("anthropic translate stream failed: %w", err))}
+			return
+		}
+
+		inputTokens := int(message.Usage.InputTokens)
+		outputTokens := int(message.Usage.OutputTokens)
+		actualTokens := inputTokens + outputTokens
+		if actualTokens == 0 {
+			actualTokens = estimatedTokens
+		}
+		p.rateLimiter.Adjust(estimatedTokens, actualTokens)
+
+		cost := calculateCost(model, inputTokens, outputTokens)
+
+		out <- translator.StreamChunk{
+			Done: true,
+			Response: &translator.TranslationResponse{
+				TranslatedText: translatedText,
+				SourceText:     req.Text,
+				TokensUsed: translator.TokenUsage{
+					InputTokens:  inputTokens,
+					OutputTokens: outputTokens,
+					TotalTokens:  actualTokens,
+				},
+				Cost: translator.Cost{
+					Amount:   cost,
+					Currency: "USD",
+				},
+				Provider: p.Name(),
+				Duration: time.Since(start),
+			},
+		}
+	}()
+
+	return out, nil
+}
+
+// TranslateBatch translates multiple texts concurrently, up to
+// opts.MaxConcurrency at a time. Each translation still goes through
+// Translate, so it gets the same rate limiting and retries as a standalone
+// call; a failure on one request doesn't abort the rest of the batch.
+func (p *Provider) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	return translator.RunBatch(ctx, reqs, opts, p.Translate), nil
 }
 
-func isRetryableError(err error) bool {
-	// Check for rate limit or server errors
-	errStr := err.Error()
-	return contains(errStr, "429") || contains(errStr, "500") || contains(errStr, "503") ||
-		contains(errStr, "overloaded")
+// wrapProviderError classifies err via errkind and wraps it so callers can
+// use errors.Is against the translator package's sentinel errors
+// (ErrRateLimited, ErrAuthFailed, ErrContentBlocked, ErrNonRetryable)
+// regardless of which provider produced it. Retryable kinds come back as a
+// *retry.RetryableError carrying that kind and any Retry-After the provider
+// reported; everything else comes back as a plain wrapped error.
+func wrapProviderError(err error) error {
+	kind := errkind.Classify(err)
+	wrapped := translator.WrapKind(err, kind)
+
+	if !kind.Retryable() {
+		return wrapped
+	}
+
+	retryableErr := &retry.RetryableError{Err: wrapped, Kind: kind}
+	if retryAfter, ok := errkind.RetryAfter(err); ok {
+		retryableErr.RetryAfter = retryAfter
+	}
+	return retryableErr
 }
 
 func contains(s, substr string) bool {
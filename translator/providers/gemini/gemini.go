@@ -3,20 +3,25 @@ package gemini
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/generative-ai-go/genai"
 	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/clock"
+	"github.com/ownlingo/ownlingo/translator/errkind"
 	"github.com/ownlingo/ownlingo/translator/ratelimit"
 	"github.com/ownlingo/ownlingo/translator/retry"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
 // Provider implements the AITranslator interface for Google Gemini
 type Provider struct {
-	client      *genai.Client
-	model       *genai.GenerativeModel
-	modelName   string
+	mu        sync.RWMutex
+	client    *genai.Client
+	modelName string
+
 	rateLimiter *ratelimit.Limiter
 	retryConfig *retry.Config
 }
@@ -28,6 +33,10 @@ type Config struct {
 	TPM         int // Tokens per minute
 	RPM         int // Requests per minute
 	RetryConfig *retry.Config
+
+	// Clock backs rate limiting and retry backoff. Defaults to the real wall
+	// clock when nil; tests can inject a clock.Fake for deterministic timing.
+	Clock clock.Clock
 }
 
 // DefaultConfig returns default Gemini configuration
@@ -52,19 +61,36 @@ func NewProvider(ctx context.Context, config *Config) (*Provider, error) {
 		return nil, fmt.Errorf("failed to create Gemini client: %w", err)
 	}
 
-	model := client.GenerativeModel(config.Model)
+	clk := config.Clock
+	if clk == nil {
+		clk = clock.Real
+	}
 
 	return &Provider{
 		client:      client,
-		model:       model,
 		modelName:   config.Model,
-		rateLimiter: ratelimit.NewLimiter(config.TPM, config.RPM),
-		retryConfig: config.RetryConfig,
+		rateLimiter: ratelimit.NewLimiterWithClock(config.TPM, config.RPM, clk),
+		retryConfig: withClock(config.RetryConfig, clk),
 	}, nil
 }
 
+// withClock returns retryConfig with its Clock set to clk if retryConfig is
+// non-nil and doesn't already specify one, leaving the caller's Config
+// untouched. A nil retryConfig is returned as-is; retry.Do applies its own
+// default (the real wall clock) in that case.
+func withClock(retryConfig *retry.Config, clk clock.Clock) *retry.Config {
+	if retryConfig == nil || retryConfig.Clock != nil {
+		return retryConfig
+	}
+	cfg := *retryConfig
+	cfg.Clock = clk
+	return &cfg
+}
+
 // Close closes the Gemini client
 func (p *Provider) Close() error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
 	return p.client.Close()
 }
 
@@ -73,6 +99,30 @@ func (p *Provider) Name() string {
 	return "gemini"
 }
 
+// Reload swaps in a new API key and model, and updates the rate limiter's
+// TPM/RPM, without disrupting any Translate/TranslateStream call already in
+// flight against the old client. The old client is left for the caller's
+// in-flight calls to finish with; it is not closed here, since closing it
+// out from under them would break those calls. cfg.Name is not checked
+// here; the caller (typically a reload.Manager) is responsible for routing
+// configs to the right provider.
+func (p *Provider) Reload(ctx context.Context, cfg translator.ProviderConfig) error {
+	client, err := genai.NewClient(ctx, option.WithAPIKey(cfg.APIKey))
+	if err != nil {
+		return fmt.Errorf("gemini reload: failed to create client: %w", err)
+	}
+
+	p.mu.Lock()
+	p.client = client
+	p.modelName = cfg.Model
+	p.mu.Unlock()
+
+	p.rateLimiter.SetTPM(cfg.TPM)
+	p.rateLimiter.SetRPM(cfg.RPM)
+
+	return nil
+}
+
 // Translate translates a single text
 func (p *Provider) Translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
 	start := time.Now()
@@ -119,18 +169,22 @@ func (p *Provider) translate(ctx context.Context, req *translator.TranslationReq
 	userPrompt := fmt.Sprintf("Translate the following text from %s to %s:\n\n%s",
 		req.SourceLanguage, req.TargetLanguage, req.Text)
 
-	// Set system instruction
-	p.model.SystemInstruction = &genai.Content{
+	p.mu.RLock()
+	client, modelName := p.client, p.modelName
+	p.mu.RUnlock()
+
+	// Build a fresh model per call instead of mutating the shared model's
+	// SystemInstruction in place: that field isn't guarded by p.mu, so two
+	// concurrent calls (TranslateBatch dispatches Translate concurrently)
+	// would race and could leak one request's system prompt into another's.
+	model := client.GenerativeModel(modelName)
+	model.SystemInstruction = &genai.Content{
 		Parts: []genai.Part{genai.Text(systemPrompt)},
 	}
 
-	resp, err := p.model.GenerateContent(ctx, genai.Text(userPrompt))
+	resp, err := model.GenerateContent(ctx, genai.Text(userPrompt))
 	if err != nil {
-		// Check if error is retryable (429 or 500)
-		if isRetryableError(err) {
-			return nil, &retry.RetryableError{Err: err}
-		}
-		return nil, err
+		return nil, wrapProviderError(err)
 	}
 
 	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
@@ -147,7 +201,7 @@ func (p *Provider) translate(ctx context.Context, req *translator.TranslationReq
 	}
 
 	// Calculate cost (approximate)
-	cost := calculateCost(p.modelName, inputTokens, outputTokens)
+	cost := calculateCost(modelName, inputTokens, outputTokens)
 
 	return &translator.TranslationResponse{
 		TranslatedText: translatedText,
@@ -164,26 +218,131 @@ func (p *Provider) translate(ctx context.Context, req *translator.TranslationReq
 	}, nil
 }
 
-// TranslateBatch translates multiple texts
-func (p *Provider) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest) ([]*translator.TranslationResponse, error) {
-	responses := make([]*translator.TranslationResponse, len(reqs))
+// TranslateStream translates a single text, streaming incremental chunks of
+// translated text as they arrive from Gemini.
+func (p *Provider) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	start := time.Now()
 
-	for i, req := range reqs {
-		resp, err := p.Translate(ctx, req)
-		if err != nil {
-			return nil, fmt.Errorf("batch translate failed at index %d: %w", i, err)
-		}
-		responses[i] = resp
+	estimatedTokens := len(req.Text) / 4
+	if estimatedTokens < 100 {
+		estimatedTokens = 100
+	}
+
+	if err := p.rateLimiter.Wait(ctx, estimatedTokens); err != nil {
+		return nil, err
+	}
+
+	systemPrompt := translator.SystemPrompt(req.PreserveHTML, req.PreserveLiquid)
+	userPrompt := fmt.Sprintf("Translate the following text from %s to %s:\n\n%s",
+		req.SourceLanguage, req.TargetLanguage, req.Text)
+
+	p.mu.RLock()
+	client, modelName := p.client, p.modelName
+	p.mu.RUnlock()
+
+	// See translate's comment: build a fresh model per call rather than
+	// mutate the shared one's SystemInstruction, which isn't guarded by p.mu.
+	model := client.GenerativeModel(modelName)
+	model.SystemInstruction = &genai.Content{
+		Parts: []genai.Part{genai.Text(systemPrompt)},
 	}
 
-	return responses, nil
+	iter := model.GenerateContentStream(ctx, genai.Text(userPrompt))
+
+	out := make(chan translator.StreamChunk)
+
+	go func() {
+		defer close(out)
+
+		var translatedText string
+		var inputTokens, outputTokens int
+
+		for {
+			resp, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				out <- translator.StreamChunk{Err: wrapProviderError(fmt.Errorf("gemini translate stream failed: %w", err))}
+				return
+			}
+
+			if resp.UsageMetadata != nil {
+				inputTokens = int(resp.UsageMetadata.PromptTokenCount)
+				outputTokens = int(resp.UsageMetadata.CandidatesTokenCount)
+			}
+
+			if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
+				continue
+			}
+
+			delta := fmt.Sprintf("%v", resp.Candidates[0].Content.Parts[0])
+			if delta == "" {
+				continue
+			}
+
+			translatedText += delta
+			out <- translator.StreamChunk{TextDelta: delta}
+		}
+
+		actualTokens := inputTokens + outputTokens
+		if actualTokens == 0 {
+			actualTokens = estimatedTokens
+		}
+		p.rateLimiter.Adjust(estimatedTokens, actualTokens)
+
+		cost := calculateCost(modelName, inputTokens, outputTokens)
+
+		out <- translator.StreamChunk{
+			Done: true,
+			Response: &translator.TranslationResponse{
+				TranslatedText: translatedText,
+				SourceText:     req.Text,
+				TokensUsed: translator.TokenUsage{
+					InputTokens:  inputTokens,
+					OutputTokens: outputTokens,
+					TotalTokens:  actualTokens,
+				},
+				Cost: translator.Cost{
+					Amount:   cost,
+					Currency: "USD",
+				},
+				Provider: p.Name(),
+				Duration: time.Since(start),
+			},
+		}
+	}()
+
+	return out, nil
+}
+
+// TranslateBatch translates multiple texts concurrently, up to
+// opts.MaxConcurrency at a time. Each translation still goes through
+// Translate, so it gets the same rate limiting and retries as a standalone
+// call; a failure on one request doesn't abort the rest of the batch.
+func (p *Provider) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	return translator.RunBatch(ctx, reqs, opts, p.Translate), nil
 }
 
-func isRetryableError(err error) bool {
-	// Check for rate limit or server errors
-	errStr := err.Error()
-	return contains(errStr, "429") || contains(errStr, "500") || contains(errStr, "503") ||
-		contains(errStr, "quota") || contains(errStr, "resource_exhausted")
+// wrapProviderError classifies err via errkind and wraps it so callers can
+// use errors.Is against the translator package's sentinel errors
+// (ErrRateLimited, ErrAuthFailed, ErrContentBlocked, ErrNonRetryable)
+// regardless of which provider produced it. Retryable kinds come back as a
+// *retry.RetryableError carrying that kind and any Retry-After the provider
+// reported; everything else comes back as a plain wrapped error.
+func wrapProviderError(err error) error {
+	kind := errkind.Classify(err)
+	wrapped := translator.WrapKind(err, kind)
+
+	if !kind.Retryable() {
+		return wrapped
+	}
+
+	retryableErr := &retry.RetryableError{Err: wrapped, Kind: kind}
+	if retryAfter, ok := errkind.RetryAfter(err); ok {
+		retryableErr.RetryAfter = retryAfter
+	}
+	return retryableErr
 }
 
 func contains(s, substr string) bool {
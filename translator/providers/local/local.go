@@ -0,0 +1,314 @@
+// Package local implements an AITranslator backed by any server that speaks
+// the OpenAI chat-completions wire format but is not OpenAI itself — LocalAI,
+// Ollama, llama.cpp server, vLLM, and similar self-hosted runtimes.
+package local
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/clock"
+	"github.com/ownlingo/ownlingo/translator/errkind"
+	"github.com/ownlingo/ownlingo/translator/ratelimit"
+	"github.com/ownlingo/ownlingo/translator/retry"
+	"github.com/sashabaranov/go-openai"
+)
+
+// Provider implements the AITranslator interface for a self-hosted,
+// OpenAI-compatible chat-completions endpoint.
+type Provider struct {
+	client      *openai.Client
+	model       string
+	costFn      CostFunc
+	rateLimiter *ratelimit.Limiter
+	retryConfig *retry.Config
+}
+
+// CostFunc computes the cost of a completion for a given model and token
+// usage. Self-hosted models typically have no per-token billing, so
+// NewProvider uses a CostFunc that always returns zero when Config.CostFn is
+// nil.
+type CostFunc func(model string, inputTokens, outputTokens int) float64
+
+// Config holds local provider configuration
+type Config struct {
+	BaseURL     string // e.g. http://localhost:8080/v1
+	Model       string
+	APIKey      string // optional; most self-hosted servers ignore it
+	TPM         int    // Tokens per minute
+	RPM         int    // Requests per minute
+	CostFn      CostFunc
+	RetryConfig *retry.Config
+
+	// Clock backs rate limiting and retry backoff. Defaults to the real wall
+	// clock when nil; tests can inject a clock.Fake for deterministic timing.
+	Clock clock.Clock
+}
+
+// DefaultConfig returns default configuration for a self-hosted endpoint at
+// baseURL serving model. Cost defaults to zero; pass a CostFn in Config to
+// override.
+func DefaultConfig(baseURL, model string) *Config {
+	return &Config{
+		BaseURL:     baseURL,
+		Model:       model,
+		TPM:         1000000, // self-hosted runtimes are rarely token-limited
+		RPM:         1000,
+		RetryConfig: retry.DefaultConfig(),
+	}
+}
+
+// NewProvider creates a new local provider
+func NewProvider(config *Config) *Provider {
+	if config == nil {
+		panic("config cannot be nil")
+	}
+	if config.BaseURL == "" {
+		panic("base URL is required")
+	}
+
+	clientConfig := openai.DefaultConfig(config.APIKey)
+	clientConfig.BaseURL = config.BaseURL
+	client := openai.NewClientWithConfig(clientConfig)
+
+	costFn := config.CostFn
+	if costFn == nil {
+		costFn = func(string, int, int) float64 { return 0 }
+	}
+
+	clk := config.Clock
+	if clk == nil {
+		clk = clock.Real
+	}
+
+	return &Provider{
+		client:      client,
+		model:       config.Model,
+		costFn:      costFn,
+		rateLimiter: ratelimit.NewLimiterWithClock(config.TPM, config.RPM, clk),
+		retryConfig: withClock(config.RetryConfig, clk),
+	}
+}
+
+// withClock returns retryConfig with its Clock set to clk if retryConfig is
+// non-nil and doesn't already specify one, leaving the caller's Config
+// untouched. A nil retryConfig is returned as-is; retry.Do applies its own
+// default (the real wall clock) in that case.
+func withClock(retryConfig *retry.Config, clk clock.Clock) *retry.Config {
+	if retryConfig == nil || retryConfig.Clock != nil {
+		return retryConfig
+	}
+	cfg := *retryConfig
+	cfg.Clock = clk
+	return &cfg
+}
+
+// Name returns the provider name
+func (p *Provider) Name() string {
+	return "local"
+}
+
+// Translate translates a single text
+func (p *Provider) Translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+	start := time.Now()
+
+	var response *translator.TranslationResponse
+	var lastErr error
+
+	err := retry.Do(ctx, p.retryConfig, func() error {
+		estimatedTokens := len(req.Text) / 4
+		if estimatedTokens < 100 {
+			estimatedTokens = 100
+		}
+
+		if err := p.rateLimiter.Wait(ctx, estimatedTokens); err != nil {
+			return err
+		}
+
+		resp, err := p.translate(ctx, req)
+		if err != nil {
+			lastErr = err
+			return err
+		}
+
+		response = resp
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("local translate failed: %w", lastErr)
+	}
+
+	response.Duration = time.Since(start)
+	response.Provider = p.Name()
+
+	return response, nil
+}
+
+func (p *Provider) translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+	systemPrompt := translator.SystemPrompt(req.PreserveHTML, req.PreserveLiquid)
+	userPrompt := fmt.Sprintf("Translate the following text from %s to %s:\n\n%s",
+		req.SourceLanguage, req.TargetLanguage, req.Text)
+
+	resp, err := p.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model: p.model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: systemPrompt},
+			{Role: openai.ChatMessageRoleUser, Content: userPrompt},
+		},
+	})
+
+	if err != nil {
+		return nil, wrapProviderError(err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("no choices returned from local provider")
+	}
+
+	translatedText := resp.Choices[0].Message.Content
+	cost := p.costFn(p.model, resp.Usage.PromptTokens, resp.Usage.CompletionTokens)
+
+	return &translator.TranslationResponse{
+		TranslatedText: translatedText,
+		SourceText:     req.Text,
+		TokensUsed: translator.TokenUsage{
+			InputTokens:  resp.Usage.PromptTokens,
+			OutputTokens: resp.Usage.CompletionTokens,
+			TotalTokens:  resp.Usage.TotalTokens,
+		},
+		Cost: translator.Cost{
+			Amount:   cost,
+			Currency: "USD",
+		},
+	}, nil
+}
+
+// TranslateStream translates a single text, streaming incremental chunks of
+// translated text as they arrive from the local server.
+func (p *Provider) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	start := time.Now()
+
+	estimatedTokens := len(req.Text) / 4
+	if estimatedTokens < 100 {
+		estimatedTokens = 100
+	}
+
+	if err := p.rateLimiter.Wait(ctx, estimatedTokens); err != nil {
+		return nil, err
+	}
+
+	systemPrompt := translator.SystemPrompt(req.PreserveHTML, req.PreserveLiquid)
+	userPrompt := fmt.Sprintf("Translate the following text from %s to %s:\n\n%s",
+		req.SourceLanguage, req.TargetLanguage, req.Text)
+
+	stream, err := p.client.CreateChatCompletionStream(ctx, openai.ChatCompletionRequest{
+		Model: p.model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: systemPrompt},
+			{Role: openai.ChatMessageRoleUser, Content: userPrompt},
+		},
+	})
+	if err != nil {
+		return nil, wrapProviderError(fmt.Errorf("local translate stream failed: %w", err))
+	}
+
+	out := make(chan translator.StreamChunk)
+
+	go func() {
+		defer close(out)
+		defer stream.Close()
+
+		var translatedText string
+		var inputTokens, outputTokens int
+
+		for {
+			chunk, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				out <- translator.StreamChunk{Err: fmt.Errorf("local stream recv failed: %w", err)}
+				return
+			}
+
+			if chunk.Usage != nil {
+				inputTokens = chunk.Usage.PromptTokens
+				outputTokens = chunk.Usage.CompletionTokens
+			}
+
+			if len(chunk.Choices) == 0 {
+				continue
+			}
+
+			delta := chunk.Choices[0].Delta.Content
+			if delta == "" {
+				continue
+			}
+
+			translatedText += delta
+			out <- translator.StreamChunk{TextDelta: delta}
+		}
+
+		actualTokens := inputTokens + outputTokens
+		if actualTokens == 0 {
+			actualTokens = estimatedTokens
+		}
+		p.rateLimiter.Adjust(estimatedTokens, actualTokens)
+
+		cost := p.costFn(p.model, inputTokens, outputTokens)
+
+		out <- translator.StreamChunk{
+			Done: true,
+			Response: &translator.TranslationResponse{
+				TranslatedText: translatedText,
+				SourceText:     req.Text,
+				TokensUsed: translator.TokenUsage{
+					InputTokens:  inputTokens,
+					OutputTokens: outputTokens,
+					TotalTokens:  actualTokens,
+				},
+				Cost: translator.Cost{
+					Amount:   cost,
+					Currency: "USD",
+				},
+				Provider: p.Name(),
+				Duration: time.Since(start),
+			},
+		}
+	}()
+
+	return out, nil
+}
+
+// TranslateBatch translates multiple texts concurrently, up to
+// opts.MaxConcurrency at a time. Each translation still goes through
+// Translate, so it gets the same rate limiting and retries as a standalone
+// call; a failure on one request doesn't abort the rest of the batch.
+func (p *Provider) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	return translator.RunBatch(ctx, reqs, opts, p.Translate), nil
+}
+
+// wrapProviderError classifies err via errkind and wraps it so callers can
+// use errors.Is against the translator package's sentinel errors
+// (ErrRateLimited, ErrAuthFailed, ErrContentBlocked, ErrNonRetryable)
+// regardless of which provider produced it. Retryable kinds come back as a
+// *retry.RetryableError carrying that kind and any Retry-After the provider
+// reported; everything else comes back as a plain wrapped error.
+func wrapProviderError(err error) error {
+	kind := errkind.Classify(err)
+	wrapped := translator.WrapKind(err, kind)
+
+	if !kind.Retryable() {
+		return wrapped
+	}
+
+	retryableErr := &retry.RetryableError{Err: wrapped, Kind: kind}
+	if retryAfter, ok := errkind.RetryAfter(err); ok {
+		retryableErr.RetryAfter = retryAfter
+	}
+	return retryableErr
+}
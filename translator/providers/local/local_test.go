@@ -0,0 +1,77 @@
+package local_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ownlingo/ownlingo/translator/clock"
+	"github.com/ownlingo/ownlingo/translator/providers/local"
+)
+
+func TestDefaultConfig(t *testing.T) {
+	config := local.DefaultConfig("http://localhost:8080/v1", "llama3")
+
+	if config.BaseURL != "http://localhost:8080/v1" {
+		t.Errorf("expected base URL 'http://localhost:8080/v1', got %q", config.BaseURL)
+	}
+
+	if config.Model != "llama3" {
+		t.Errorf("expected model 'llama3', got %q", config.Model)
+	}
+
+	if config.TPM <= 0 {
+		t.Error("expected TPM > 0")
+	}
+
+	if config.RPM <= 0 {
+		t.Error("expected RPM > 0")
+	}
+
+	if config.RetryConfig == nil {
+		t.Error("expected retry config to be set")
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	config := local.DefaultConfig("http://localhost:8080/v1", "llama3")
+	provider := local.NewProvider(config)
+
+	if provider == nil {
+		t.Fatal("expected provider to be created")
+	}
+
+	if provider.Name() != "local" {
+		t.Errorf("expected provider name 'local', got %q", provider.Name())
+	}
+}
+
+func TestNewProviderPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic when creating provider with nil config")
+		}
+	}()
+
+	local.NewProvider(nil)
+}
+
+func TestNewProviderAcceptsSuppliedClock(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+	config := local.DefaultConfig("http://localhost:8080/v1", "llama3")
+	config.Clock = fake
+
+	provider := local.NewProvider(config)
+	if provider == nil {
+		t.Fatal("expected provider to be created")
+	}
+}
+
+func TestNewProviderRequiresBaseURL(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic when creating provider with no base URL")
+		}
+	}()
+
+	local.NewProvider(&local.Config{Model: "llama3"})
+}
@@ -3,9 +3,12 @@ package openai
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/clock"
+	"github.com/ownlingo/ownlingo/translator/errkind"
 	"github.com/ownlingo/ownlingo/translator/ratelimit"
 	"github.com/ownlingo/ownlingo/translator/retry"
 	"github.com/sashabaranov/go-openai"
@@ -26,6 +29,10 @@ type Config struct {
 	TPM         int // Tokens per minute
 	RPM         int // Requests per minute
 	RetryConfig *retry.Config
+
+	// Clock backs rate limiting and retry backoff. Defaults to the real wall
+	// clock when nil; tests can inject a clock.Fake for deterministic timing.
+	Clock clock.Clock
 }
 
 // DefaultConfig returns default OpenAI configuration
@@ -47,14 +54,32 @@ func NewProvider(config *Config) *Provider {
 
 	client := openai.NewClient(config.APIKey)
 
+	clk := config.Clock
+	if clk == nil {
+		clk = clock.Real
+	}
+
 	return &Provider{
 		client:      client,
 		model:       config.Model,
-		rateLimiter: ratelimit.NewLimiter(config.TPM, config.RPM),
-		retryConfig: config.RetryConfig,
+		rateLimiter: ratelimit.NewLimiterWithClock(config.TPM, config.RPM, clk),
+		retryConfig: withClock(config.RetryConfig, clk),
 	}
 }
 
+// withClock returns retryConfig with its Clock set to clk if retryConfig is
+// non-nil and doesn't already specify one, leaving the caller's Config
+// untouched. A nil retryConfig is returned as-is; retry.Do applies its own
+// default (the real wall clock) in that case.
+func withClock(retryConfig *retry.Config, clk clock.Clock) *retry.Config {
+	if retryConfig == nil || retryConfig.Clock != nil {
+		return retryConfig
+	}
+	cfg := *retryConfig
+	cfg.Clock = clk
+	return &cfg
+}
+
 // Name returns the provider name
 func (p *Provider) Name() string {
 	return "openai"
@@ -121,11 +146,7 @@ func (p *Provider) translate(ctx context.Context, req *translator.TranslationReq
 	})
 
 	if err != nil {
-		// Check if error is retryable (429 or 500)
-		if isRetryableError(err) {
-			return nil, &retry.RetryableError{Err: err}
-		}
-		return nil, err
+		return nil, wrapProviderError(err)
 	}
 
 	if len(resp.Choices) == 0 {
@@ -152,48 +173,131 @@ func (p *Provider) translate(ctx context.Context, req *translator.TranslationReq
 	}, nil
 }
 
-// TranslateBatch translates multiple texts
-func (p *Provider) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest) ([]*translator.TranslationResponse, error) {
-	responses := make([]*translator.TranslationResponse, len(reqs))
+// TranslateStream translates a single text, streaming incremental chunks of
+// translated text as they arrive from OpenAI.
+func (p *Provider) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	start := time.Now()
 
-	for i, req := range reqs {
-		resp, err := p.Translate(ctx, req)
-		if err != nil {
-			return nil, fmt.Errorf("batch translate failed at index %d: %w", i, err)
-		}
-		responses[i] = resp
+	estimatedTokens := len(req.Text) / 4
+	if estimatedTokens < 100 {
+		estimatedTokens = 100
 	}
 
-	return responses, nil
-}
+	if err := p.rateLimiter.Wait(ctx, estimatedTokens); err != nil {
+		return nil, err
+	}
 
-func isRetryableError(err error) bool {
-	// Check for rate limit or server errors
-	// This is a simplified check - in production, you'd parse the actual error
-	errStr := err.Error()
-	return contains(errStr, "429") || contains(errStr, "500") || contains(errStr, "503")
-}
+	systemPrompt := translator.SystemPrompt(req.PreserveHTML, req.PreserveLiquid)
+	userPrompt := fmt.Sprintf("Translate the following text from %s to %s:\n\n%s",
+		req.SourceLanguage, req.TargetLanguage, req.Text)
 
-func contains(s, substr string) bool {
-	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) &&
-		(hasPrefix(s, substr) || hasSuffix(s, substr) || hasInfix(s, substr)))
-}
+	stream, err := p.client.CreateChatCompletionStream(ctx, openai.ChatCompletionRequest{
+		Model: p.model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: systemPrompt},
+			{Role: openai.ChatMessageRoleUser, Content: userPrompt},
+		},
+	})
+	if err != nil {
+		return nil, wrapProviderError(fmt.Errorf("openai translate stream failed: %w", err))
+	}
 
-func hasPrefix(s, prefix string) bool {
-	return len(s) >= len(prefix) && s[:len(prefix)] == prefix
+	out := make(chan translator.StreamChunk)
+
+	go func() {
+		defer close(out)
+		defer stream.Close()
+
+		var translatedText string
+		var inputTokens, outputTokens int
+
+		for {
+			chunk, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				out <- translator.StreamChunk{Err: fmt.Errorf("openai stream recv failed: %w", err)}
+				return
+			}
+
+			if chunk.Usage != nil {
+				inputTokens = chunk.Usage.PromptTokens
+				outputTokens = chunk.Usage.CompletionTokens
+			}
+
+			if len(chunk.Choices) == 0 {
+				continue
+			}
+
+			delta := chunk.Choices[0].Delta.Content
+			if delta == "" {
+				continue
+			}
+
+			translatedText += delta
+			out <- translator.StreamChunk{TextDelta: delta}
+		}
+
+		actualTokens := inputTokens + outputTokens
+		if actualTokens == 0 {
+			// Usage wasn't reported mid-stream; fall back to the estimate.
+			actualTokens = estimatedTokens
+		}
+		p.rateLimiter.Adjust(estimatedTokens, actualTokens)
+
+		cost := calculateCost(p.model, inputTokens, outputTokens)
+
+		out <- translator.StreamChunk{
+			Done: true,
+			Response: &translator.TranslationResponse{
+				TranslatedText: translatedText,
+				SourceText:     req.Text,
+				TokensUsed: translator.TokenUsage{
+					InputTokens:  inputTokens,
+					OutputTokens: outputTokens,
+					TotalTokens:  actualTokens,
+				},
+				Cost: translator.Cost{
+					Amount:   cost,
+					Currency: "USD",
+				},
+				Provider: p.Name(),
+				Duration: time.Since(start),
+			},
+		}
+	}()
+
+	return out, nil
 }
 
-func hasSuffix(s, suffix string) bool {
-	return len(s) >= len(suffix) && s[len(s)-len(suffix):] == suffix
+// TranslateBatch translates multiple texts concurrently, up to
+// opts.MaxConcurrency at a time. Each translation still goes through
+// Translate, so it gets the same rate limiting and retries as a standalone
+// call; a failure on one request doesn't abort the rest of the batch.
+func (p *Provider) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	return translator.RunBatch(ctx, reqs, opts, p.Translate), nil
 }
 
-func hasInfix(s, infix string) bool {
-	for i := 0; i <= len(s)-len(infix); i++ {
-		if s[i:i+len(infix)] == infix {
-			return true
-		}
+// wrapProviderError classifies err via errkind and wraps it so callers can
+// use errors.Is against the translator package's sentinel errors
+// (ErrRateLimited, ErrAuthFailed, ErrContentBlocked, ErrNonRetryable)
+// regardless of which provider produced it. Retryable kinds come back as a
+// *retry.RetryableError carrying that kind and any Retry-After the provider
+// reported; everything else comes back as a plain wrapped error.
+func wrapProviderError(err error) error {
+	kind := errkind.Classify(err)
+	wrapped := translator.WrapKind(err, kind)
+
+	if !kind.Retryable() {
+		return wrapped
+	}
+
+	retryableErr := &retry.RetryableError{Err: wrapped, Kind: kind}
+	if retryAfter, ok := errkind.RetryAfter(err); ok {
+		retryableErr.RetryAfter = retryAfter
 	}
-	return false
+	return retryableErr
 }
 
 // calculateCost calculates the cost based on token usage
@@ -4,12 +4,15 @@ import (
 	"context"
 	"sync"
 	"time"
+
+	"github.com/ownlingo/ownlingo/translator/clock"
 )
 
 // Limiter implements rate limiting for tokens per minute (TPM) and requests per minute (RPM)
 type Limiter struct {
 	tpm int   // Tokens per minute limit
 	rpm int   // Requests per minute limit
+	clk clock.Clock
 
 	tokensMu       sync.Mutex
 	tokens         int
@@ -20,12 +23,21 @@ type Limiter struct {
 	requestsLastFill time.Time
 }
 
-// NewLimiter creates a new rate limiter with specified TPM and RPM limits
+// NewLimiter creates a new rate limiter with specified TPM and RPM limits,
+// backed by the real wall clock.
 func NewLimiter(tpm, rpm int) *Limiter {
-	now := time.Now()
+	return NewLimiterWithClock(tpm, rpm, clock.Real)
+}
+
+// NewLimiterWithClock creates a new rate limiter backed by clk instead of the
+// real wall clock, letting tests drive token refill and minute-window
+// rollover deterministically with a clock.Fake.
+func NewLimiterWithClock(tpm, rpm int, clk clock.Clock) *Limiter {
+	now := clk.Now()
 	return &Limiter{
 		tpm:              tpm,
 		rpm:              rpm,
+		clk:              clk,
 		tokens:           tpm,
 		tokensLastFill:   now,
 		requests:         rpm,
@@ -54,7 +66,7 @@ func (l *Limiter) waitRequests(ctx context.Context) error {
 
 	for {
 		// Refill based on elapsed time
-		now := time.Now()
+		now := l.clk.Now()
 		elapsed := now.Sub(l.requestsLastFill)
 		if elapsed >= time.Minute {
 			l.requests = l.rpm
@@ -74,7 +86,7 @@ func (l *Limiter) waitRequests(ctx context.Context) error {
 		case <-ctx.Done():
 			l.requestsMu.Lock()
 			return ctx.Err()
-		case <-time.After(waitTime):
+		case <-l.clk.After(waitTime):
 			l.requestsMu.Lock()
 		}
 	}
@@ -86,7 +98,7 @@ func (l *Limiter) waitTokens(ctx context.Context, tokensNeeded int) error {
 
 	for {
 		// Refill based on elapsed time
-		now := time.Now()
+		now := l.clk.Now()
 		elapsed := now.Sub(l.tokensLastFill)
 		if elapsed >= time.Minute {
 			l.tokens = l.tpm
@@ -106,12 +118,28 @@ func (l *Limiter) waitTokens(ctx context.Context, tokensNeeded int) error {
 		case <-ctx.Done():
 			l.tokensMu.Lock()
 			return ctx.Err()
-		case <-time.After(waitTime):
+		case <-l.clk.After(waitTime):
 			l.tokensMu.Lock()
 		}
 	}
 }
 
+// Adjust reconciles the token budget after the real usage for a request
+// becomes known (e.g. once a stream completes and reports final usage),
+// crediting back an over-estimate or debiting an under-estimate. It never
+// pushes the running total above the configured TPM for the current window.
+func (l *Limiter) Adjust(estimatedTokens, actualTokens int) {
+	delta := estimatedTokens - actualTokens
+
+	l.tokensMu.Lock()
+	defer l.tokensMu.Unlock()
+
+	l.tokens += delta
+	if l.tokens > l.tpm {
+		l.tokens = l.tpm
+	}
+}
+
 // SetTPM updates the tokens per minute limit
 func (l *Limiter) SetTPM(tpm int) {
 	l.tokensMu.Lock()
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ownlingo/ownlingo/translator/clock"
 	"github.com/ownlingo/ownlingo/translator/ratelimit"
 )
 
@@ -78,33 +79,85 @@ func TestLimiterMultipleRequests(t *testing.T) {
 	}
 }
 
-func TestLimiterTokenRefill(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping test in short mode")
+func TestLimiterAdjustCreditsOverEstimate(t *testing.T) {
+	limiter := ratelimit.NewLimiter(1000, 10)
+	ctx := context.Background()
+
+	if err := limiter.Wait(ctx, 200); err != nil {
+		t.Fatalf("expected no error, got %v", err)
 	}
 
-	// Use very small limits for faster testing
-	limiter := ratelimit.NewLimiter(100, 10)
+	// Actual usage was lower than the estimate; the difference should be credited back.
+	limiter.Adjust(200, 50)
+
+	// 1000 - 200 (initial wait) + 150 (credited back) = 950 tokens should now be available.
+	if err := limiter.Wait(ctx, 950); err != nil {
+		t.Fatalf("expected credited tokens to be available, got %v", err)
+	}
+}
+
+func TestLimiterAdjustNeverExceedsTPM(t *testing.T) {
+	limiter := ratelimit.NewLimiter(1000, 10)
+	limiter.Adjust(0, -5000) // wildly over-credit
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(ctx, 1001); err == nil {
+		t.Error("expected Adjust to never push the token budget above the configured TPM")
+	}
+}
+
+func TestLimiterTokenRefill(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+	limiter := ratelimit.NewLimiterWithClock(100, 10, fake)
 	ctx := context.Background()
 
 	// Exhaust tokens
-	err := limiter.Wait(ctx, 100)
-	if err != nil {
+	if err := limiter.Wait(ctx, 100); err != nil {
 		t.Fatalf("initial wait failed: %v", err)
 	}
 
-	// This would normally wait, but we're not testing the full wait time
-	// Just verify it doesn't error immediately
-	start := time.Now()
+	done := make(chan error, 1)
+	go func() { done <- limiter.Wait(ctx, 50) }()
 
-	ctx2, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
-	defer cancel()
+	// Give the waiting goroutine a chance to block on the fake clock's After
+	// before advancing past the minute window; this doesn't wait on real time
+	// passing, only on the goroutine being scheduled.
+	time.Sleep(10 * time.Millisecond)
+	fake.Advance(time.Minute)
 
-	_ = limiter.Wait(ctx2, 50)
-	duration := time.Since(start)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("expected refill to unblock Wait, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected Wait to return once the fake clock crossed the minute window")
+	}
+}
 
-	// Should have waited at least a bit
-	if duration < 50*time.Millisecond {
-		t.Logf("waited %v (expected some delay)", duration)
+func TestLimiterRequestWindowRollsOverOnFakeClock(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+	limiter := ratelimit.NewLimiterWithClock(1000, 1, fake)
+	ctx := context.Background()
+
+	if err := limiter.Wait(ctx, 10); err != nil {
+		t.Fatalf("first request failed: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- limiter.Wait(ctx, 10) }()
+
+	time.Sleep(10 * time.Millisecond)
+	fake.Advance(time.Minute)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("expected the request window to roll over, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected Wait to return once the fake clock crossed the minute window")
 	}
 }
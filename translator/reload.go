@@ -0,0 +1,47 @@
+package translator
+
+import "context"
+
+// ProviderConfig is the subset of a provider's configuration that can
+// change while the process keeps running: credentials, model, and rate
+// limits.
+type ProviderConfig struct {
+	// Name must match the target provider's Name(), so a ReloadableConfig
+	// can address multiple providers by name.
+	Name   string
+	APIKey string
+	Model  string
+	TPM    int // Tokens per minute
+	RPM    int // Requests per minute
+}
+
+// ReloadableConfig is the full configuration a ConfigSource supplies.
+// Providers is ordered; callers that also maintain a fallback chain treat
+// that order as the desired primary→secondary→... fallback order.
+type ReloadableConfig struct {
+	Providers []ProviderConfig
+}
+
+// Reloadable is implemented by providers that support swapping credentials,
+// model, and rate limits at runtime via Reload, without dropping any
+// Translate/TranslateBatch/TranslateStream call already in flight against
+// the provider's previous configuration.
+type Reloadable interface {
+	AITranslator
+	Reload(ctx context.Context, cfg ProviderConfig) error
+}
+
+// ConfigSource supplies the configuration consumed by Reload. Implementations
+// live in translator/configsource.
+type ConfigSource interface {
+	// Load reads the latest configuration from the source's origin (a file,
+	// a remote config service, or whatever else backs this source).
+	Load() (*ReloadableConfig, error)
+
+	// Changes is notified whenever the source detects new configuration to
+	// load (configsource.FileSource signals it on SIGHUP). Sources that
+	// can't observe changes themselves (e.g. a configsource.FuncSource with
+	// no ChangeChan) return a channel that's never written to; callers
+	// trigger a reload explicitly instead.
+	Changes() <-chan struct{}
+}
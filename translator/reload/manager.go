@@ -0,0 +1,89 @@
+// Package reload wires a translator.ConfigSource to a set of named
+// translator.Reloadable providers and an optional fallback.Chain, applying
+// configuration changes as they arrive.
+package reload
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/fallback"
+)
+
+// Manager applies configuration from a translator.ConfigSource to a set of
+// named providers and, if a chain is configured, reorders it to match.
+type Manager struct {
+	source    translator.ConfigSource
+	providers map[string]translator.Reloadable
+	chain     *fallback.Chain
+}
+
+// NewManager creates a Manager that applies configuration from source to
+// providers, matched by their Name(). If chain is non-nil, Reload also
+// reorders chain's members to the order given by the loaded configuration.
+func NewManager(source translator.ConfigSource, chain *fallback.Chain, providers ...translator.Reloadable) *Manager {
+	byName := make(map[string]translator.Reloadable, len(providers))
+	for _, p := range providers {
+		byName[p.Name()] = p
+	}
+
+	return &Manager{
+		source:    source,
+		providers: byName,
+		chain:     chain,
+	}
+}
+
+// Reload loads the latest configuration and applies it to every named
+// provider, then, if a chain was configured, reorders the chain to match. A
+// provider named in the config but not registered with the Manager fails the
+// whole call before any provider's Reload is invoked, so a typo later in the
+// config can't leave earlier providers already running against new
+// credentials while the call as a whole reports failure.
+func (m *Manager) Reload(ctx context.Context) error {
+	cfg, err := m.source.Load()
+	if err != nil {
+		return fmt.Errorf("reload: %w", err)
+	}
+
+	providers := make([]translator.Reloadable, len(cfg.Providers))
+	for i, pc := range cfg.Providers {
+		p, ok := m.providers[pc.Name]
+		if !ok {
+			return fmt.Errorf("reload: no provider registered with name %q", pc.Name)
+		}
+		providers[i] = p
+	}
+
+	ordered := make([]translator.AITranslator, 0, len(cfg.Providers))
+	for i, pc := range cfg.Providers {
+		if err := providers[i].Reload(ctx, pc); err != nil {
+			return fmt.Errorf("reload: provider %q: %w", pc.Name, err)
+		}
+		ordered = append(ordered, providers[i])
+	}
+
+	if m.chain != nil && len(ordered) > 0 {
+		m.chain.SetProviders(ordered...)
+	}
+
+	return nil
+}
+
+// Run blocks, calling Reload each time the config source signals a change,
+// until ctx is canceled. Reload errors are reported to onError (if
+// non-nil) rather than stopping the loop, so one bad config doesn't wedge
+// hot-reload for the rest of the process's life.
+func (m *Manager) Run(ctx context.Context, onError func(error)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-m.source.Changes():
+			if err := m.Reload(ctx); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
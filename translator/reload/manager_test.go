@@ -0,0 +1,190 @@
+package reload_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/configsource"
+	"github.com/ownlingo/ownlingo/translator/fallback"
+	"github.com/ownlingo/ownlingo/translator/reload"
+)
+
+// fakeProvider is a translator.Reloadable whose Reload just records the
+// config it was given, so tests can assert on what was applied. If
+// reloaded is non-nil, Reload signals it after recording the config.
+type fakeProvider struct {
+	name     string
+	reloaded chan struct{}
+
+	mu  sync.Mutex
+	cfg translator.ProviderConfig
+}
+
+func (p *fakeProvider) Name() string { return p.name }
+
+func (p *fakeProvider) Translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+	return &translator.TranslationResponse{TranslatedText: "translated: " + req.Text, SourceText: req.Text, Provider: p.name}, nil
+}
+
+func (p *fakeProvider) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	return translator.RunBatch(ctx, reqs, opts, p.Translate), nil
+}
+
+func (p *fakeProvider) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (p *fakeProvider) Reload(ctx context.Context, cfg translator.ProviderConfig) error {
+	p.mu.Lock()
+	p.cfg = cfg
+	p.mu.Unlock()
+
+	if p.reloaded != nil {
+		select {
+		case p.reloaded <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (p *fakeProvider) config() translator.ProviderConfig {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.cfg
+}
+
+func TestManagerReloadAppliesNewConfig(t *testing.T) {
+	primary := &fakeProvider{name: "primary"}
+	secondary := &fakeProvider{name: "secondary"}
+	chain := fallback.NewChain(primary)
+
+	source := &configsource.FuncSource{
+		LoadFunc: func() (*translator.ReloadableConfig, error) {
+			return &translator.ReloadableConfig{
+				Providers: []translator.ProviderConfig{
+					{Name: "secondary", APIKey: "key-secondary", Model: "model-b", TPM: 1000, RPM: 10},
+					{Name: "primary", APIKey: "key-primary", Model: "model-a", TPM: 2000, RPM: 20},
+				},
+			}, nil
+		},
+	}
+
+	manager := reload.NewManager(source, chain, primary, secondary)
+
+	if err := manager.Reload(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got := primary.config(); got.APIKey != "key-primary" || got.Model != "model-a" {
+		t.Errorf("primary not reloaded with expected config, got %+v", got)
+	}
+	if got := secondary.config(); got.APIKey != "key-secondary" || got.Model != "model-b" {
+		t.Errorf("secondary not reloaded with expected config, got %+v", got)
+	}
+
+	if !contains(chain.Name(), "secondary") {
+		t.Errorf("expected chain to be reordered with secondary first, got %q", chain.Name())
+	}
+}
+
+func TestManagerReloadUnknownProviderFails(t *testing.T) {
+	primary := &fakeProvider{name: "primary"}
+	chain := fallback.NewChain(primary)
+
+	source := &configsource.FuncSource{
+		LoadFunc: func() (*translator.ReloadableConfig, error) {
+			return &translator.ReloadableConfig{
+				Providers: []translator.ProviderConfig{
+					{Name: "unregistered"},
+				},
+			}, nil
+		},
+	}
+
+	manager := reload.NewManager(source, chain, primary)
+
+	if err := manager.Reload(context.Background()); err == nil {
+		t.Fatal("expected error for unregistered provider name")
+	}
+}
+
+func TestManagerReloadUnknownProviderLeavesEarlierProvidersUntouched(t *testing.T) {
+	primary := &fakeProvider{name: "primary", cfg: translator.ProviderConfig{APIKey: "original-key"}}
+	chain := fallback.NewChain(primary)
+
+	source := &configsource.FuncSource{
+		LoadFunc: func() (*translator.ReloadableConfig, error) {
+			return &translator.ReloadableConfig{
+				Providers: []translator.ProviderConfig{
+					{Name: "primary", APIKey: "new-key"},
+					{Name: "unregistered"},
+				},
+			}, nil
+		},
+	}
+
+	manager := reload.NewManager(source, chain, primary)
+
+	if err := manager.Reload(context.Background()); err == nil {
+		t.Fatal("expected error for unregistered provider name")
+	}
+
+	if got := primary.config(); got.APIKey != "original-key" {
+		t.Errorf("expected primary's config untouched by the failed reload, got %+v", got)
+	}
+}
+
+func TestManagerRunAppliesReloadOnChange(t *testing.T) {
+	primary := &fakeProvider{name: "primary", reloaded: make(chan struct{}, 1)}
+	chain := fallback.NewChain(primary)
+
+	changes := make(chan struct{}, 1)
+	source := &configsource.FuncSource{
+		LoadFunc: func() (*translator.ReloadableConfig, error) {
+			return &translator.ReloadableConfig{
+				Providers: []translator.ProviderConfig{
+					{Name: "primary", APIKey: "new-key", Model: "new-model", TPM: 500, RPM: 5},
+				},
+			}, nil
+		},
+		ChangeChan: changes,
+	}
+
+	manager := reload.NewManager(source, chain, primary)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runDone := make(chan error, 1)
+	go func() {
+		runDone <- manager.Run(ctx, nil)
+	}()
+
+	changes <- struct{}{}
+
+	select {
+	case <-primary.reloaded:
+	case <-time.After(time.Second):
+		t.Fatal("expected Run to have applied a reload after a change signal")
+	}
+	if got := primary.config(); got.APIKey != "new-key" {
+		t.Errorf("expected new-key applied, got %+v", got)
+	}
+
+	cancel()
+	if err := <-runDone; err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func contains(s, substr string) bool {
+	for i := 0; i <= len(s)-len(substr); i++ {
+		if s[i:i+len(substr)] == substr {
+			return true
+		}
+	}
+	return false
+}
@@ -5,12 +5,22 @@ import (
 	"errors"
 	"math"
 	"time"
+
+	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/clock"
+	"github.com/ownlingo/ownlingo/translator/errkind"
 )
 
 // RetryableError indicates an error that can be retried
 type RetryableError struct {
 	Err        error
 	StatusCode int
+	Kind       errkind.Kind
+
+	// RetryAfter, if non-zero, is honored verbatim as the next backoff
+	// instead of the exponential schedule in Config. Providers populate it
+	// from a rate-limit response's Retry-After header when available.
+	RetryAfter time.Duration
 }
 
 func (e *RetryableError) Error() string {
@@ -21,10 +31,23 @@ func (e *RetryableError) Unwrap() error {
 	return e.Err
 }
 
-// IsRetryable checks if an error is retryable
+// IsRetryable reports whether err is worth retrying: either a
+// *RetryableError produced by a provider, a context deadline (the caller's
+// timeout may simply have been too short for this attempt), or one of the
+// translator package's sentinels for a known-transient failure class
+// (ErrRateLimited) that reached here unwrapped.
 func IsRetryable(err error) bool {
 	var retryableErr *RetryableError
-	return errors.As(err, &retryableErr)
+	if errors.As(err, &retryableErr) {
+		return true
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	if errors.Is(err, translator.ErrRateLimited) {
+		return true
+	}
+	return false
 }
 
 // Config holds retry configuration
@@ -33,6 +56,11 @@ type Config struct {
 	InitialBackoff time.Duration
 	MaxBackoff     time.Duration
 	Multiplier     float64
+
+	// Clock is used to sleep between retries. Defaults to the real wall
+	// clock when nil, letting tests inject a clock.Fake to drive
+	// exponential-backoff sequences deterministically, without real sleeps.
+	Clock clock.Clock
 }
 
 // DefaultConfig returns default retry configuration
@@ -50,6 +78,10 @@ func Do(ctx context.Context, config *Config, operation func() error) error {
 	if config == nil {
 		config = DefaultConfig()
 	}
+	clk := config.Clock
+	if clk == nil {
+		clk = clock.Real
+	}
 
 	var lastErr error
 
@@ -71,13 +103,18 @@ func Do(ctx context.Context, config *Config, operation func() error) error {
 			break
 		}
 
-		// Calculate backoff duration
+		// Calculate backoff duration, honoring a provider-reported
+		// Retry-After instead of the exponential schedule when available.
 		backoff := calculateBackoff(config, attempt)
+		var retryableErr *RetryableError
+		if errors.As(err, &retryableErr) && retryableErr.RetryAfter > 0 {
+			backoff = retryableErr.RetryAfter
+		}
 
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-time.After(backoff):
+		case <-clk.After(backoff):
 			// Continue to next attempt
 		}
 	}
@@ -3,9 +3,12 @@ package retry_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/clock"
 	"github.com/ownlingo/ownlingo/translator/retry"
 )
 
@@ -46,6 +49,21 @@ func TestIsRetryable(t *testing.T) {
 			err:  nil,
 			want: false,
 		},
+		{
+			name: "context deadline exceeded",
+			err:  fmt.Errorf("call timed out: %w", context.DeadlineExceeded),
+			want: true,
+		},
+		{
+			name: "unwrapped ErrRateLimited sentinel",
+			err:  fmt.Errorf("provider throttled us: %w", translator.ErrRateLimited),
+			want: true,
+		},
+		{
+			name: "unwrapped ErrAuthFailed sentinel is not treated as retryable",
+			err:  fmt.Errorf("bad credentials: %w", translator.ErrAuthFailed),
+			want: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -198,6 +216,97 @@ func TestDoContextCancellation(t *testing.T) {
 	}
 }
 
+func TestDoHonorsRetryAfter(t *testing.T) {
+	ctx := context.Background()
+	config := &retry.Config{
+		MaxRetries:     1,
+		InitialBackoff: 1 * time.Hour, // would time out the test if used
+		MaxBackoff:     1 * time.Hour,
+		Multiplier:     2.0,
+	}
+
+	callCount := 0
+	operation := func() error {
+		callCount++
+		if callCount == 1 {
+			return &retry.RetryableError{Err: errors.New("rate limited"), RetryAfter: 1 * time.Millisecond}
+		}
+		return nil
+	}
+
+	err := retry.Do(ctx, config, operation)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if callCount != 2 {
+		t.Errorf("expected 2 calls, got %d", callCount)
+	}
+}
+
+func TestDoBackoffSequenceOnFakeClock(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+	config := &retry.Config{
+		MaxRetries:     3,
+		InitialBackoff: 1 * time.Second,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2.0,
+		Clock:          fake,
+	}
+
+	var seenBackoffs []time.Duration
+	lastCall := fake.Now()
+
+	callCount := 0
+	done := make(chan error, 1)
+
+	operation := func() error {
+		now := fake.Now()
+		if callCount > 0 {
+			seenBackoffs = append(seenBackoffs, now.Sub(lastCall))
+		}
+		lastCall = now
+		callCount++
+		if callCount <= 3 {
+			return &retry.RetryableError{Err: errors.New("retryable")}
+		}
+		return nil
+	}
+
+	go func() { done <- retry.Do(context.Background(), config, operation) }()
+
+	// Advance past each expected backoff in turn; the fake clock only
+	// unblocks retry.Do's sleep once Advance crosses its deadline, so this
+	// drives the whole exponential sequence without any real waiting.
+	for _, want := range []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second} {
+		time.Sleep(5 * time.Millisecond)
+		fake.Advance(want)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("expected eventual success, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected retry.Do to finish once the fake clock advanced through every backoff")
+	}
+
+	if callCount != 4 {
+		t.Fatalf("expected 4 calls, got %d", callCount)
+	}
+
+	want := []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second}
+	if len(seenBackoffs) != len(want) {
+		t.Fatalf("expected %d recorded backoffs, got %d: %v", len(want), len(seenBackoffs), seenBackoffs)
+	}
+	for i, w := range want {
+		if seenBackoffs[i] != w {
+			t.Errorf("backoff %d: expected %v, got %v", i, w, seenBackoffs[i])
+		}
+	}
+}
+
 func TestDoNilConfig(t *testing.T) {
 	ctx := context.Background()
 
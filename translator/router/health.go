@@ -0,0 +1,167 @@
+package router
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ownlingo/ownlingo/translator/errkind"
+)
+
+// Default cooldown tuning for unhealthy providers. A provider's cooldown
+// grows exponentially with consecutive failures, mirroring the backoff
+// math in the retry package, capped at maxCooldown.
+const (
+	baseCooldown       = 1 * time.Second
+	cooldownMultiplier = 2.0
+	maxCooldown        = 5 * time.Minute
+
+	// unauthorizedCooldown is used for 401/403 failures: retrying with the
+	// same credentials won't help, so the provider is parked for a long time
+	// rather than being retried on the usual exponential schedule.
+	unauthorizedCooldown = 24 * time.Hour
+)
+
+// providerHealth tracks the health signals for a single provider.
+type providerHealth struct {
+	consecutiveFailures int
+	requests            int
+	errors              int
+	unhealthyUntil      time.Time
+
+	avgLatency time.Duration
+	avgCost    float64
+}
+
+// Tracker records per-provider health signals (consecutive failures, the
+// error rate over every request recorded, and EWMA latency/cost) and decides
+// whether a provider is currently healthy enough to route to. A failure's
+// class is used only to give unauthorized errors a long cooldown; counts per
+// failure class (rate limits, 5xx, and so on) are not kept.
+type Tracker struct {
+	mu    sync.Mutex
+	state map[string]*providerHealth
+}
+
+// NewTracker creates an empty health tracker. Every provider starts healthy.
+func NewTracker() *Tracker {
+	return &Tracker{state: make(map[string]*providerHealth)}
+}
+
+// RecordSuccess marks a provider as having served a request successfully,
+// clearing its failure streak and updating its rolling latency/cost averages.
+func (t *Tracker) RecordSuccess(name string, latency time.Duration, cost float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h := t.entry(name)
+	h.consecutiveFailures = 0
+	h.unhealthyUntil = time.Time{}
+	h.requests++
+
+	const ewmaWeight = 0.2
+	if h.requests == 1 {
+		h.avgLatency = latency
+		h.avgCost = cost
+		return
+	}
+	h.avgLatency = time.Duration(float64(h.avgLatency)*(1-ewmaWeight) + float64(latency)*ewmaWeight)
+	h.avgCost = h.avgCost*(1-ewmaWeight) + cost*ewmaWeight
+}
+
+// RecordFailure marks a provider as having failed a request and updates its
+// cooldown. Unauthorized errors (401/403) get a long cooldown since retrying
+// with the same credentials will not help; other errors back off
+// exponentially with the consecutive failure count.
+func (t *Tracker) RecordFailure(name string, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h := t.entry(name)
+	h.requests++
+	h.errors++
+	h.consecutiveFailures++
+
+	if isUnauthorized(err) {
+		h.unhealthyUntil = time.Now().Add(unauthorizedCooldown)
+		return
+	}
+
+	h.unhealthyUntil = time.Now().Add(cooldown(h.consecutiveFailures))
+}
+
+// IsHealthy reports whether a provider is currently outside its cooldown
+// window. Providers with no recorded history are always healthy.
+func (t *Tracker) IsHealthy(name string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h, ok := t.state[name]
+	if !ok {
+		return true
+	}
+	return time.Now().After(h.unhealthyUntil)
+}
+
+// ErrorRate returns the fraction of recorded requests for name that failed,
+// over every request recorded since the Tracker was created; it is not
+// limited to a recent window.
+func (t *Tracker) ErrorRate(name string) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h, ok := t.state[name]
+	if !ok || h.requests == 0 {
+		return 0
+	}
+	return float64(h.errors) / float64(h.requests)
+}
+
+// AverageLatency returns the EWMA of observed response durations for name.
+func (t *Tracker) AverageLatency(name string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if h, ok := t.state[name]; ok {
+		return h.avgLatency
+	}
+	return 0
+}
+
+// AverageCost returns the EWMA of observed response cost amounts for name.
+func (t *Tracker) AverageCost(name string) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if h, ok := t.state[name]; ok {
+		return h.avgCost
+	}
+	return 0
+}
+
+// entry returns the health record for name, creating one if needed. Callers
+// must hold t.mu.
+func (t *Tracker) entry(name string) *providerHealth {
+	h, ok := t.state[name]
+	if !ok {
+		h = &providerHealth{}
+		t.state[name] = h
+	}
+	return h
+}
+
+func cooldown(consecutiveFailures int) time.Duration {
+	d := baseCooldown
+	for i := 1; i < consecutiveFailures; i++ {
+		d = time.Duration(float64(d) * cooldownMultiplier)
+		if d > maxCooldown {
+			return maxCooldown
+		}
+	}
+	return d
+}
+
+// isUnauthorized reports whether err classifies as a 401/403 from the
+// underlying provider SDK.
+func isUnauthorized(err error) bool {
+	return errkind.Classify(err) == errkind.Unauthorized
+}
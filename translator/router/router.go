@@ -0,0 +1,229 @@
+// Package router provides health-aware routing across multiple AI
+// translation providers, with a circuit breaker that skips providers that
+// are currently unhealthy and pluggable strategies for ordering the
+// providers that remain.
+package router
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ownlingo/ownlingo/translator"
+)
+
+// Router routes translation requests across a set of providers, skipping
+// ones that are currently unhealthy and falling back to the next candidate
+// on failure, similar to fallback.Chain but informed by recorded health
+// signals instead of always trying providers in a fixed order.
+type Router struct {
+	providers []translator.AITranslator
+	health    *Tracker
+	strategy  Strategy
+}
+
+// New creates a Router over the given providers using strategy to order
+// healthy candidates on each call. Pass PriorityWithHealth{} for
+// primary→secondary behavior with circuit breaking, or one of RoundRobin,
+// WeightedByCost, LeastLatency for other routing policies.
+func New(strategy Strategy, providers ...translator.AITranslator) *Router {
+	if len(providers) == 0 {
+		panic("at least one provider is required")
+	}
+	if strategy == nil {
+		strategy = PriorityWithHealth{}
+	}
+
+	return &Router{
+		providers: providers,
+		health:    NewTracker(),
+		strategy:  strategy,
+	}
+}
+
+// Name returns the name of the router (primary provider name).
+func (r *Router) Name() string {
+	return fmt.Sprintf("router(%s)", r.providers[0].Name())
+}
+
+// Health returns the Tracker backing this router's circuit breaker, so
+// callers can inspect provider health or export it as metrics.
+func (r *Router) Health() *Tracker {
+	return r.health
+}
+
+// candidates returns the providers to try, in order. Unhealthy providers are
+// skipped unless every provider is unhealthy, in which case all providers
+// are offered as a last resort rather than failing outright.
+func (r *Router) candidates() []translator.AITranslator {
+	healthy := make([]translator.AITranslator, 0, len(r.providers))
+	for _, p := range r.providers {
+		if r.health.IsHealthy(p.Name()) {
+			healthy = append(healthy, p)
+		}
+	}
+
+	if len(healthy) == 0 {
+		return r.strategy.Order(r.providers, r.health)
+	}
+	return r.strategy.Order(healthy, r.health)
+}
+
+// Translate attempts translation against healthy providers in strategy order,
+// falling back to the next candidate on failure.
+func (r *Router) Translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+	var errs []error
+
+	candidates := r.candidates()
+	for i, provider := range candidates {
+		resp, err := provider.Translate(ctx, req)
+		if err == nil {
+			r.health.RecordSuccess(provider.Name(), resp.Duration, resp.Cost.Amount)
+			return resp, nil
+		}
+
+		r.health.RecordFailure(provider.Name(), err)
+		errs = append(errs, fmt.Errorf("provider %s (%d/%d) failed: %w",
+			provider.Name(), i+1, len(candidates), err))
+	}
+
+	return nil, translator.JoinProviderErrors(errs)
+}
+
+// TranslateBatch attempts a batch translation against healthy providers in
+// strategy order. Requests that fail against one provider are retried
+// against the next rather than failing the whole batch; only requests that
+// fail against every candidate come back with an error. Health is recorded
+// per provider per round, from however many of its requests succeeded. opts
+// is passed unchanged to each candidate's TranslateBatch, so opts.OnProgress
+// reports each round separately, with that round's request count as total.
+func (r *Router) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	results := make([]translator.BatchResult, len(reqs))
+
+	remaining := reqs
+	remainingIdx := make([]int, len(reqs))
+	for i := range remainingIdx {
+		remainingIdx[i] = i
+	}
+
+	errsByIdx := make([][]error, len(reqs))
+
+	candidates := r.candidates()
+	for i, provider := range candidates {
+		if len(remaining) == 0 {
+			break
+		}
+
+		batchResults, err := provider.TranslateBatch(ctx, remaining, opts)
+		if err != nil {
+			r.health.RecordFailure(provider.Name(), err)
+			wrapped := fmt.Errorf("provider %s (%d/%d) failed: %w",
+				provider.Name(), i+1, len(candidates), err)
+			for _, idx := range remainingIdx {
+				errsByIdx[idx] = append(errsByIdx[idx], wrapped)
+			}
+			continue
+		}
+
+		var nextRemaining []*translator.TranslationRequest
+		var nextRemainingIdx []int
+		var succeeded []translator.BatchResult
+		var roundErr error
+
+		for j, res := range batchResults {
+			origIdx := remainingIdx[j]
+			if res.Err != nil {
+				roundErr = res.Err
+				errsByIdx[origIdx] = append(errsByIdx[origIdx], fmt.Errorf("provider %s (%d/%d) failed on one request: %w",
+					provider.Name(), i+1, len(candidates), res.Err))
+				nextRemaining = append(nextRemaining, remaining[j])
+				nextRemainingIdx = append(nextRemainingIdx, origIdx)
+				continue
+			}
+			results[origIdx] = res
+			succeeded = append(succeeded, res)
+		}
+
+		if len(succeeded) > 0 {
+			responses := make([]*translator.TranslationResponse, len(succeeded))
+			for j, res := range succeeded {
+				responses[j] = res.Response
+			}
+			r.health.RecordSuccess(provider.Name(), totalDuration(responses)/time.Duration(len(responses)), totalCost(responses))
+		}
+		if roundErr != nil {
+			r.health.RecordFailure(provider.Name(), roundErr)
+		}
+
+		remaining = nextRemaining
+		remainingIdx = nextRemainingIdx
+	}
+
+	for _, idx := range remainingIdx {
+		results[idx] = translator.BatchResult{Err: translator.JoinProviderErrors(errsByIdx[idx])}
+	}
+
+	return results, nil
+}
+
+// TranslateStream attempts a streaming translation against healthy providers
+// in strategy order. Like fallback.Chain, it only falls back to the next
+// candidate if the chosen provider fails before delivering any text.
+func (r *Router) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	var errs []error
+
+	candidates := r.candidates()
+	for i, provider := range candidates {
+		stream, err := provider.TranslateStream(ctx, req)
+		if err != nil {
+			r.health.RecordFailure(provider.Name(), err)
+			errs = append(errs, fmt.Errorf("provider %s (%d/%d) failed: %w",
+				provider.Name(), i+1, len(candidates), err))
+			continue
+		}
+
+		first, ok := <-stream
+		if !ok || first.Err != nil {
+			if ok {
+				r.health.RecordFailure(provider.Name(), first.Err)
+				errs = append(errs, fmt.Errorf("provider %s (%d/%d) failed before streaming any text: %w",
+					provider.Name(), i+1, len(candidates), first.Err))
+			} else {
+				errs = append(errs, fmt.Errorf("provider %s (%d/%d) closed its stream before streaming any text",
+					provider.Name(), i+1, len(candidates)))
+			}
+			continue
+		}
+
+		out := make(chan translator.StreamChunk)
+		go func(provider translator.AITranslator) {
+			defer close(out)
+			out <- first
+			for chunk := range stream {
+				if chunk.Done && chunk.Response != nil {
+					r.health.RecordSuccess(provider.Name(), chunk.Response.Duration, chunk.Response.Cost.Amount)
+				}
+				out <- chunk
+			}
+		}(provider)
+		return out, nil
+	}
+
+	return nil, translator.JoinProviderErrors(errs)
+}
+
+func totalDuration(responses []*translator.TranslationResponse) time.Duration {
+	var total time.Duration
+	for _, resp := range responses {
+		total += resp.Duration
+	}
+	return total
+}
+
+func totalCost(responses []*translator.TranslationResponse) float64 {
+	var total float64
+	for _, resp := range responses {
+		total += resp.Cost.Amount
+	}
+	return total
+}
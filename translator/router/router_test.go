@@ -0,0 +1,196 @@
+package router_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/router"
+)
+
+type mockTranslator struct {
+	name      string
+	shouldErr bool
+	errMsg    string
+}
+
+func (m *mockTranslator) Name() string { return m.name }
+
+func (m *mockTranslator) Translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+	if m.shouldErr {
+		return nil, errors.New(m.errMsg)
+	}
+	return &translator.TranslationResponse{TranslatedText: "translated: " + req.Text, Provider: m.name}, nil
+}
+
+func (m *mockTranslator) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	results := make([]translator.BatchResult, len(reqs))
+	for i, req := range reqs {
+		if m.shouldErr {
+			results[i] = translator.BatchResult{Err: errors.New(m.errMsg)}
+			continue
+		}
+		results[i] = translator.BatchResult{Response: &translator.TranslationResponse{TranslatedText: "translated: " + req.Text, Provider: m.name}}
+	}
+	return results, nil
+}
+
+func (m *mockTranslator) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	if m.shouldErr {
+		return nil, errors.New(m.errMsg)
+	}
+	out := make(chan translator.StreamChunk, 1)
+	out <- translator.StreamChunk{Done: true, Response: &translator.TranslationResponse{TranslatedText: "translated: " + req.Text, Provider: m.name}}
+	close(out)
+	return out, nil
+}
+
+func TestNewRouterPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic when creating router with no providers")
+		}
+	}()
+
+	router.New(router.PriorityWithHealth{})
+}
+
+func TestRouterTranslateSuccess(t *testing.T) {
+	provider := &mockTranslator{name: "primary"}
+	r := router.New(router.PriorityWithHealth{}, provider)
+
+	resp, err := r.Translate(context.Background(), &translator.TranslationRequest{Text: "Hello"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if resp.Provider != "primary" {
+		t.Errorf("expected provider 'primary', got %q", resp.Provider)
+	}
+}
+
+func TestRouterSkipsUnhealthyProvider(t *testing.T) {
+	failing := &mockTranslator{name: "failing", shouldErr: true, errMsg: "boom"}
+	healthy := &mockTranslator{name: "healthy"}
+
+	r := router.New(router.PriorityWithHealth{}, failing, healthy)
+
+	// Drive enough failures to put "failing" into cooldown.
+	_, _ = r.Translate(context.Background(), &translator.TranslationRequest{Text: "Hello"})
+
+	if r.Health().IsHealthy("failing") {
+		t.Fatal("expected provider to be marked unhealthy after a failure")
+	}
+
+	resp, err := r.Translate(context.Background(), &translator.TranslationRequest{Text: "Hello"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if resp.Provider != "healthy" {
+		t.Errorf("expected the unhealthy provider to be skipped, got %q", resp.Provider)
+	}
+}
+
+func TestRouterUnauthorizedGetsLongCooldown(t *testing.T) {
+	unauthorized := &mockTranslator{name: "unauthorized", shouldErr: true, errMsg: "401 unauthorized"}
+	healthy := &mockTranslator{name: "healthy"}
+
+	r := router.New(router.PriorityWithHealth{}, unauthorized, healthy)
+	_, _ = r.Translate(context.Background(), &translator.TranslationRequest{Text: "Hello"})
+
+	// A single 401 should be enough to mark the provider unhealthy for a long
+	// time, not just the short base cooldown used for transient errors.
+	if r.Health().IsHealthy("unauthorized") {
+		t.Fatal("expected unauthorized provider to be immediately unhealthy")
+	}
+
+	// A first transient failure cools down for one second; the unauthorized
+	// provider must still be parked once that has passed.
+	time.Sleep(1100 * time.Millisecond)
+	if r.Health().IsHealthy("unauthorized") {
+		t.Error("expected unauthorized provider to stay unhealthy past the base cooldown")
+	}
+}
+
+func TestRouterAllUnhealthyStillTries(t *testing.T) {
+	provider := &mockTranslator{name: "only", shouldErr: true, errMsg: "down"}
+	r := router.New(router.PriorityWithHealth{}, provider)
+
+	// The first call fails and puts the only provider into cooldown.
+	_, _ = r.Translate(context.Background(), &translator.TranslationRequest{Text: "Hello"})
+	if r.Health().IsHealthy("only") {
+		t.Fatal("expected provider to be marked unhealthy after a failure")
+	}
+
+	_, err := r.Translate(context.Background(), &translator.TranslationRequest{Text: "Hello"})
+	if err == nil {
+		t.Fatal("expected an error when the only provider is unhealthy")
+	}
+	if !strings.Contains(err.Error(), "down") {
+		t.Errorf("expected the unhealthy provider to still be tried, got %v", err)
+	}
+}
+
+func TestRoundRobinRotatesStart(t *testing.T) {
+	a := &mockTranslator{name: "a"}
+	b := &mockTranslator{name: "b"}
+
+	r := router.New(&router.RoundRobin{}, a, b)
+
+	first, err := r.Translate(context.Background(), &translator.TranslationRequest{Text: "Hello"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	second, err := r.Translate(context.Background(), &translator.TranslationRequest{Text: "Hello"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if first.Provider == second.Provider {
+		t.Errorf("expected round-robin to alternate providers, got %q then %q", first.Provider, second.Provider)
+	}
+}
+
+func TestRouterTranslateBatchFallsBackPerRequest(t *testing.T) {
+	failing := &mockTranslator{name: "failing", shouldErr: true, errMsg: "boom"}
+	healthy := &mockTranslator{name: "healthy"}
+
+	r := router.New(router.PriorityWithHealth{}, failing, healthy)
+
+	reqs := []*translator.TranslationRequest{{Text: "Hello"}, {Text: "World"}}
+	results, err := r.TranslateBatch(context.Background(), reqs, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for i, res := range results {
+		if res.Err != nil {
+			t.Fatalf("result %d: unexpected error: %v", i, res.Err)
+		}
+		if res.Response.Provider != "healthy" {
+			t.Errorf("result %d: expected fallback to 'healthy', got %q", i, res.Response.Provider)
+		}
+	}
+
+	if r.Health().IsHealthy("failing") {
+		t.Error("expected 'failing' to be marked unhealthy after the batch")
+	}
+}
+
+func TestLeastLatencyPrefersFasterProvider(t *testing.T) {
+	tracker := router.NewTracker()
+	tracker.RecordSuccess("slow", 200*time.Millisecond, 0)
+	tracker.RecordSuccess("fast", 10*time.Millisecond, 0)
+
+	ordered := router.LeastLatency{}.Order([]translator.AITranslator{
+		&mockTranslator{name: "slow"},
+		&mockTranslator{name: "fast"},
+	}, tracker)
+
+	if ordered[0].Name() != "fast" {
+		t.Errorf("expected 'fast' to be ordered first, got %q", ordered[0].Name())
+	}
+}
@@ -0,0 +1,77 @@
+package router
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"github.com/ownlingo/ownlingo/translator"
+)
+
+// Strategy orders a set of providers by preference for the next request. The
+// Router filters out unhealthy providers before calling Order, except when
+// every provider is unhealthy, in which case the full candidate set is
+// passed through so the Router still has something to try.
+type Strategy interface {
+	Order(candidates []translator.AITranslator, health *Tracker) []translator.AITranslator
+}
+
+// PriorityWithHealth keeps the configured primary→secondary→... order. This
+// is the default strategy and matches fallback.Chain's behavior, except that
+// the Router has already filtered out unhealthy providers before Order runs.
+type PriorityWithHealth struct{}
+
+func (PriorityWithHealth) Order(candidates []translator.AITranslator, _ *Tracker) []translator.AITranslator {
+	return candidates
+}
+
+// RoundRobin cycles the starting provider on every call so load is spread
+// evenly across healthy providers instead of always preferring the first one.
+type RoundRobin struct {
+	next uint64
+}
+
+func (r *RoundRobin) Order(candidates []translator.AITranslator, _ *Tracker) []translator.AITranslator {
+	if len(candidates) == 0 {
+		return candidates
+	}
+
+	start := int(atomic.AddUint64(&r.next, 1)-1) % len(candidates)
+
+	ordered := make([]translator.AITranslator, len(candidates))
+	for i := range candidates {
+		ordered[i] = candidates[(start+i)%len(candidates)]
+	}
+	return ordered
+}
+
+// WeightedByCost prefers providers with the lowest observed average cost per
+// request. Providers with no cost history yet are tried first so they get a
+// chance to report a real number.
+type WeightedByCost struct{}
+
+func (WeightedByCost) Order(candidates []translator.AITranslator, health *Tracker) []translator.AITranslator {
+	return sortByKey(candidates, func(name string) float64 {
+		return health.AverageCost(name)
+	})
+}
+
+// LeastLatency prefers providers with the lowest observed EWMA response
+// latency. Providers with no latency history yet are tried first.
+type LeastLatency struct{}
+
+func (LeastLatency) Order(candidates []translator.AITranslator, health *Tracker) []translator.AITranslator {
+	return sortByKey(candidates, func(name string) float64 {
+		return float64(health.AverageLatency(name))
+	})
+}
+
+// sortByKey returns a stable copy of candidates ordered ascending by key(name).
+func sortByKey(candidates []translator.AITranslator, key func(name string) float64) []translator.AITranslator {
+	ordered := make([]translator.AITranslator, len(candidates))
+	copy(ordered, candidates)
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return key(ordered[i].Name()) < key(ordered[j].Name())
+	})
+	return ordered
+}
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ownlingo/ownlingo/translator"
+)
+
+// wantsSSE reports whether the client asked for a streaming response, either
+// via the OpenAI-style "stream" body field (checked by the caller) or by
+// sending an SSE Accept header.
+func wantsSSE(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+// handleChatCompletions implements an OpenAI-compatible /v1/chat/completions
+// endpoint backed by the gateway's AITranslator. The text to translate is
+// taken from the last "user" message; source_language/target_language/
+// preserve_html/preserve_liquid are ownlingo extension fields.
+func (s *Server) handleChatCompletions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	var body chatCompletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	text, ok := lastUserMessage(body.Messages)
+	if !ok {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("no user message found to translate"))
+		return
+	}
+
+	req := &translator.TranslationRequest{
+		Text:           text,
+		SourceLanguage: body.SourceLanguage,
+		TargetLanguage: body.TargetLanguage,
+		PreserveHTML:   body.PreserveHTML,
+		PreserveLiquid: body.PreserveLiquid,
+	}
+
+	if body.Stream || wantsSSE(r) {
+		s.streamChatCompletion(w, r, body.Model, req)
+		return
+	}
+
+	resp, err := s.translator.Translate(r.Context(), req)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	finishReason := "stop"
+	writeJSON(w, http.StatusOK, chatCompletionResponse{
+		ID:      completionID(),
+		Object:  "chat.completion",
+		Created: time.Now().Unix(),
+		Model:   body.Model,
+		Choices: []chatCompletionChoice{
+			{
+				Index:        0,
+				Message:      chatMessage{Role: "assistant", Content: resp.TranslatedText},
+				FinishReason: &finishReason,
+			},
+		},
+		Usage: toChatUsage(resp.TokensUsed),
+	})
+}
+
+func (s *Server) streamChatCompletion(w http.ResponseWriter, r *http.Request, model string, req *translator.TranslationRequest) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("streaming unsupported by response writer"))
+		return
+	}
+
+	stream, err := s.translator.TranslateStream(r.Context(), req)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	id := completionID()
+	created := time.Now().Unix()
+
+	for chunk := range stream {
+		if chunk.Err != nil {
+			writeSSE(w, flusher, map[string]string{"error": chunk.Err.Error()})
+			return
+		}
+
+		if chunk.Done {
+			finishReason := "stop"
+			writeSSE(w, flusher, chatCompletionResponse{
+				ID:      id,
+				Object:  "chat.completion.chunk",
+				Created: created,
+				Model:   model,
+				Choices: []chatCompletionChoice{{Index: 0, Delta: chatMessage{}, FinishReason: &finishReason}},
+				Usage:   toChatUsage(chunk.Response.TokensUsed),
+			})
+			fmt.Fprint(w, "data: [DONE]\n\n")
+			flusher.Flush()
+			return
+		}
+
+		writeSSE(w, flusher, chatCompletionResponse{
+			ID:      id,
+			Object:  "chat.completion.chunk",
+			Created: created,
+			Model:   model,
+			Choices: []chatCompletionChoice{{Index: 0, Delta: chatMessage{Content: chunk.TextDelta}}},
+		})
+	}
+}
+
+func writeSSE(w http.ResponseWriter, flusher http.Flusher, payload interface{}) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "data: %s\n\n", data)
+	flusher.Flush()
+}
+
+// handleTranslate implements ownlingo's own, simpler /v1/translate endpoint.
+func (s *Server) handleTranslate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	var body translateRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	req := &translator.TranslationRequest{
+		Text:           body.Text,
+		SourceLanguage: body.SourceLanguage,
+		TargetLanguage: body.TargetLanguage,
+		PreserveHTML:   body.PreserveHTML,
+		PreserveLiquid: body.PreserveLiquid,
+	}
+
+	if wantsSSE(r) {
+		s.streamChatCompletion(w, r, "", req)
+		return
+	}
+
+	resp, err := s.translator.Translate(r.Context(), req)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, translateResponse{
+		TranslatedText: resp.TranslatedText,
+		Provider:       resp.Provider,
+		TokensUsed:     resp.TokensUsed,
+		Cost:           resp.Cost,
+	})
+}
+
+var completionCounter int64
+
+// completionID generates an OpenAI-style completion identifier. It is not
+// cryptographically unique, only unique enough to correlate chunks within a
+// single streamed response.
+func completionID() string {
+	n := atomic.AddInt64(&completionCounter, 1)
+	return fmt.Sprintf("chatcmpl-%d-%d", time.Now().UnixNano(), n)
+}
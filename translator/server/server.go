@@ -0,0 +1,68 @@
+// Package server exposes an AITranslator over HTTP using an
+// OpenAI-compatible wire format, so other applications can use ownlingo as a
+// drop-in translation gateway with routing, rate-limiting, and retries
+// handled centrally — similar to how LocalAI fronts multiple backends behind
+// one API.
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ownlingo/ownlingo/translator"
+)
+
+// Config holds HTTP gateway configuration.
+type Config struct {
+	Addr       string // e.g. ":8080"
+	Translator translator.AITranslator
+}
+
+// Server is an HTTP gateway backed by an AITranslator (including a
+// fallback.Chain or router.Router, since both satisfy the interface).
+type Server struct {
+	translator translator.AITranslator
+	httpServer *http.Server
+}
+
+// New creates a gateway Server. It does not start listening until
+// ListenAndServe is called.
+func New(config *Config) *Server {
+	if config == nil {
+		panic("config cannot be nil")
+	}
+	if config.Translator == nil {
+		panic("translator cannot be nil")
+	}
+
+	s := &Server{translator: config.Translator}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/chat/completions", s.handleChatCompletions)
+	mux.HandleFunc("/v1/translate", s.handleTranslate)
+
+	s.httpServer = &http.Server{
+		Addr:    config.Addr,
+		Handler: mux,
+	}
+
+	return s
+}
+
+// Handler returns the gateway's http.Handler, for embedding into another
+// mux or wrapping with middleware.
+func (s *Server) Handler() http.Handler {
+	return s.httpServer.Handler
+}
+
+// ListenAndServe starts the gateway and blocks until it returns an error
+// (http.ErrServerClosed on a graceful Shutdown).
+func (s *Server) ListenAndServe() error {
+	return s.httpServer.ListenAndServe()
+}
+
+// Shutdown gracefully shuts down the gateway, waiting for in-flight
+// requests to complete or ctx to be done.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
@@ -0,0 +1,268 @@
+package server_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ownlingo/ownlingo/translator"
+	"github.com/ownlingo/ownlingo/translator/server"
+)
+
+type mockTranslator struct{}
+
+func (mockTranslator) Name() string { return "mock" }
+
+func (mockTranslator) Translate(ctx context.Context, req *translator.TranslationRequest) (*translator.TranslationResponse, error) {
+	return &translator.TranslationResponse{
+		TranslatedText: "translated: " + req.Text,
+		SourceText:     req.Text,
+		Provider:       "mock",
+		TokensUsed:     translator.TokenUsage{InputTokens: 1, OutputTokens: 1, TotalTokens: 2},
+	}, nil
+}
+
+func (mockTranslator) TranslateBatch(ctx context.Context, reqs []*translator.TranslationRequest, opts *translator.BatchOptions) ([]translator.BatchResult, error) {
+	results := make([]translator.BatchResult, len(reqs))
+	for i, req := range reqs {
+		resp, err := mockTranslator{}.Translate(ctx, req)
+		results[i] = translator.BatchResult{Response: resp, Err: err}
+	}
+	return results, nil
+}
+
+func (mockTranslator) TranslateStream(ctx context.Context, req *translator.TranslationRequest) (<-chan translator.StreamChunk, error) {
+	out := make(chan translator.StreamChunk, 2)
+	out <- translator.StreamChunk{TextDelta: "translated: " + req.Text}
+	out <- translator.StreamChunk{
+		Done: true,
+		Response: &translator.TranslationResponse{
+			TranslatedText: "translated: " + req.Text,
+			Provider:       "mock",
+			TokensUsed:     translator.TokenUsage{InputTokens: 1, OutputTokens: 1, TotalTokens: 2},
+		},
+	}
+	close(out)
+	return out, nil
+}
+
+func TestHandleTranslate(t *testing.T) {
+	srv := httptest.NewServer(server.New(&server.Config{Translator: mockTranslator{}}).Handler())
+	defer srv.Close()
+
+	body, _ := json.Marshal(map[string]string{
+		"text":            "Hello",
+		"source_language": "en",
+		"target_language": "es",
+	})
+
+	resp, err := http.Post(srv.URL+"/v1/translate", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if decoded["translated_text"] != "translated: Hello" {
+		t.Errorf("expected translated text 'translated: Hello', got %v", decoded["translated_text"])
+	}
+}
+
+func TestHandleChatCompletions(t *testing.T) {
+	srv := httptest.NewServer(server.New(&server.Config{Translator: mockTranslator{}}).Handler())
+	defer srv.Close()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"model": "ownlingo",
+		"messages": []map[string]string{
+			{"role": "user", "content": "Hello"},
+		},
+		"source_language": "en",
+		"target_language": "es",
+	})
+
+	resp, err := http.Post(srv.URL+"/v1/chat/completions", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	var decoded struct {
+		Choices []struct {
+			Message struct {
+				Content string `json:"content"`
+			} `json:"message"`
+		} `json:"choices"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(decoded.Choices) != 1 || decoded.Choices[0].Message.Content != "translated: Hello" {
+		t.Errorf("unexpected chat completion response: %+v", decoded)
+	}
+}
+
+func TestHandleChatCompletionsNoUserMessage(t *testing.T) {
+	srv := httptest.NewServer(server.New(&server.Config{Translator: mockTranslator{}}).Handler())
+	defer srv.Close()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"model":    "ownlingo",
+		"messages": []map[string]string{{"role": "system", "content": "be nice"}},
+	})
+
+	resp, err := http.Post(srv.URL+"/v1/chat/completions", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", resp.StatusCode)
+	}
+}
+
+func TestHandleChatCompletionsStreamsSSEWithStreamField(t *testing.T) {
+	srv := httptest.NewServer(server.New(&server.Config{Translator: mockTranslator{}}).Handler())
+	defer srv.Close()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"model":  "ownlingo",
+		"stream": true,
+		"messages": []map[string]string{
+			{"role": "user", "content": "Hello"},
+		},
+		"source_language": "en",
+		"target_language": "es",
+	})
+
+	resp, err := http.Post(srv.URL+"/v1/chat/completions", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("expected Content-Type text/event-stream, got %q", ct)
+	}
+
+	lines := readSSEDataLines(t, resp.Body)
+	assertSSEStreamedDelta(t, lines, "translated: Hello")
+}
+
+func TestHandleChatCompletionsStreamsSSEWithAcceptHeader(t *testing.T) {
+	srv := httptest.NewServer(server.New(&server.Config{Translator: mockTranslator{}}).Handler())
+	defer srv.Close()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"model": "ownlingo",
+		"messages": []map[string]string{
+			{"role": "user", "content": "Hello"},
+		},
+		"source_language": "en",
+		"target_language": "es",
+	})
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/v1/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	lines := readSSEDataLines(t, resp.Body)
+	assertSSEStreamedDelta(t, lines, "translated: Hello")
+}
+
+// readSSEDataLines reads every "data: ..." payload out of an SSE response
+// body, in order, including the trailing "[DONE]" sentinel.
+func readSSEDataLines(t *testing.T, body io.Reader) []string {
+	t.Helper()
+
+	var lines []string
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if data, ok := strings.CutPrefix(line, "data: "); ok {
+			lines = append(lines, data)
+		}
+	}
+	return lines
+}
+
+// assertSSEStreamedDelta checks that the SSE stream contains at least one
+// chunk carrying wantDelta and ends with the "[DONE]" sentinel.
+func assertSSEStreamedDelta(t *testing.T, dataLines []string, wantDelta string) {
+	t.Helper()
+
+	if len(dataLines) == 0 {
+		t.Fatal("expected at least one SSE data line")
+	}
+	if dataLines[len(dataLines)-1] != "[DONE]" {
+		t.Errorf("expected stream to end with [DONE], got %q", dataLines[len(dataLines)-1])
+	}
+
+	found := false
+	for _, line := range dataLines[:len(dataLines)-1] {
+		var chunk struct {
+			Choices []struct {
+				Delta struct {
+					Content string `json:"content"`
+				} `json:"delta"`
+			} `json:"choices"`
+		}
+		if err := json.Unmarshal([]byte(line), &chunk); err != nil {
+			t.Fatalf("failed to decode SSE chunk %q: %v", line, err)
+		}
+		for _, c := range chunk.Choices {
+			if c.Delta.Content == wantDelta {
+				found = true
+			}
+		}
+	}
+	if !found {
+		t.Errorf("expected a streamed delta %q, got data lines: %v", wantDelta, dataLines)
+	}
+}
+
+func TestNewServerPanicsOnNilTranslator(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic when creating server with nil translator")
+		}
+	}()
+
+	server.New(&server.Config{})
+}
@@ -0,0 +1,84 @@
+package server
+
+import "github.com/ownlingo/ownlingo/translator"
+
+// chatMessage mirrors the OpenAI chat message shape.
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// chatCompletionRequest mirrors OpenAI's /v1/chat/completions request body,
+// extended with ownlingo-specific fields for translation behavior.
+type chatCompletionRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+
+	SourceLanguage string `json:"source_language"`
+	TargetLanguage string `json:"target_language"`
+	PreserveHTML   bool   `json:"preserve_html"`
+	PreserveLiquid bool   `json:"preserve_liquid"`
+}
+
+type chatCompletionUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+type chatCompletionChoice struct {
+	Index        int         `json:"index"`
+	Message      chatMessage `json:"message,omitempty"`
+	Delta        chatMessage `json:"delta,omitempty"`
+	FinishReason *string     `json:"finish_reason"`
+}
+
+// chatCompletionResponse mirrors OpenAI's /v1/chat/completions response body
+// for both the non-streaming response and each streamed chunk.
+type chatCompletionResponse struct {
+	ID      string                 `json:"id"`
+	Object  string                 `json:"object"`
+	Created int64                  `json:"created"`
+	Model   string                 `json:"model"`
+	Choices []chatCompletionChoice `json:"choices"`
+	Usage   *chatCompletionUsage   `json:"usage,omitempty"`
+}
+
+// translateRequest is ownlingo's simpler, non-OpenAI-shaped endpoint.
+type translateRequest struct {
+	Text           string `json:"text"`
+	SourceLanguage string `json:"source_language"`
+	TargetLanguage string `json:"target_language"`
+	PreserveHTML   bool   `json:"preserve_html"`
+	PreserveLiquid bool   `json:"preserve_liquid"`
+}
+
+// translateResponse is the response body for /v1/translate.
+type translateResponse struct {
+	TranslatedText string                `json:"translated_text"`
+	Provider       string                `json:"provider"`
+	TokensUsed     translator.TokenUsage `json:"tokens_used"`
+	Cost           translator.Cost       `json:"cost"`
+}
+
+func toChatUsage(usage translator.TokenUsage) *chatCompletionUsage {
+	return &chatCompletionUsage{
+		PromptTokens:     usage.InputTokens,
+		CompletionTokens: usage.OutputTokens,
+		TotalTokens:      usage.TotalTokens,
+	}
+}
+
+func stringPtr(s string) *string { return &s }
+
+// lastUserMessage returns the content of the last message with role "user",
+// which the gateway treats as the text to translate.
+func lastUserMessage(messages []chatMessage) (string, bool) {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role == "user" {
+			return messages[i].Content, true
+		}
+	}
+	return "", false
+}
@@ -10,13 +10,53 @@ type AITranslator interface {
 	// Translate translates a single text from source language to target language
 	Translate(ctx context.Context, req *TranslationRequest) (*TranslationResponse, error)
 
-	// TranslateBatch translates multiple texts in a single request
-	TranslateBatch(ctx context.Context, reqs []*TranslationRequest) ([]*TranslationResponse, error)
+	// TranslateBatch translates multiple texts, dispatching up to
+	// opts.MaxConcurrency translations at a time (pass nil for the default).
+	// It returns one BatchResult per request, in the same order as reqs, so a
+	// failure on one request does not prevent the rest from completing.
+	TranslateBatch(ctx context.Context, reqs []*TranslationRequest, opts *BatchOptions) ([]BatchResult, error)
+
+	// TranslateStream translates a single text, delivering translated text
+	// incrementally on the returned channel as it arrives from the provider.
+	// The final value on the channel has Done set to true and carries the
+	// completed TranslationResponse (including final usage/cost); the channel
+	// is closed after that value is sent.
+	TranslateStream(ctx context.Context, req *TranslationRequest) (<-chan StreamChunk, error)
 
 	// Name returns the provider name
 	Name() string
 }
 
+// StreamChunk represents one incremental piece of a streaming translation.
+// Consumers should keep reading until Done is true or Err is non-nil.
+type StreamChunk struct {
+	TextDelta string              // incremental translated text, empty on the final chunk
+	Done      bool                // true on the last chunk
+	Response  *TranslationResponse // populated only when Done is true
+	Err       error                // set if streaming failed; the channel is closed afterward
+}
+
+// BatchResult pairs one request's translation outcome with its error, so
+// TranslateBatch can report partial success instead of aborting the whole
+// batch on the first failure.
+type BatchResult struct {
+	Response *TranslationResponse
+	Err      error
+}
+
+// BatchOptions configures how TranslateBatch dispatches a batch of requests.
+type BatchOptions struct {
+	// MaxConcurrency caps how many translations run at once. A provider may
+	// apply its own default when zero or negative.
+	MaxConcurrency int
+
+	// OnProgress, if set, is called after each request in the batch
+	// completes (success or failure) with the number done so far and the
+	// batch total. Called from whichever goroutine finishes the request, so
+	// implementations must be safe to call concurrently.
+	OnProgress func(done, total int)
+}
+
 // TranslationRequest represents a translation request
 type TranslationRequest struct {
 	Text           string